@@ -163,7 +163,15 @@ var database_models []interface{} = []interface{}{
 	&models.MediaURL{},
 	&models.Album{},
 	&models.MediaEXIF{},
+	&models.MediaPanorama{},
+	&models.MediaStack{},
+	&models.Tag{},
+	&models.MediaTag{},
+	&models.MediaRawMetadata{},
+	&models.MediaOverride{},
 	&models.VideoMetadata{},
+	&models.VideoStream{},
+	&models.VideoChapter{},
 	&models.ShareToken{},
 	&models.UserMediaData{},
 	&models.UserAlbums{},
@@ -180,6 +188,10 @@ func MigrateDatabase(db *gorm.DB) error {
 		log.Printf("Setup UserAlbums join table failed: %v\n", err)
 	}
 
+	if err := db.SetupJoinTable(&models.Media{}, "Tags", &models.MediaTag{}); err != nil {
+		log.Printf("Setup MediaTag join table failed: %v\n", err)
+	}
+
 	if err := db.AutoMigrate(database_models...); err != nil {
 		log.Printf("Auto migration failed: %v\n", err)
 	}
@@ -14,6 +14,9 @@ type Loaders struct {
 	MediaThumbnail      *MediaURLLoader
 	MediaHighres        *MediaURLLoader
 	MediaVideoWeb       *MediaURLLoader
+	MediaMotionVideo    *MediaURLLoader
+	MediaVideoHLS       *MediaURLLoader
+	MediaVideoPreview   *MediaURLLoader
 	UserFromAccessToken *UserLoader
 	UserMediaFavorite   *UserFavoritesLoader
 }
@@ -26,6 +29,9 @@ func Middleware(db *gorm.DB) mux.MiddlewareFunc {
 				MediaThumbnail:      NewThumbnailMediaURLLoader(db),
 				MediaHighres:        NewHighresMediaURLLoader(db),
 				MediaVideoWeb:       NewVideoWebMediaURLLoader(db),
+				MediaMotionVideo:    NewMotionVideoMediaURLLoader(db),
+				MediaVideoHLS:       NewVideoHLSMediaURLLoader(db),
+				MediaVideoPreview:   NewVideoPreviewMediaURLLoader(db),
 				UserFromAccessToken: NewUserLoaderByToken(db),
 				UserMediaFavorite:   NewUserFavoriteLoader(db),
 			})
@@ -66,7 +66,38 @@ func NewVideoWebMediaURLLoader(db *gorm.DB) *MediaURLLoader {
 		maxBatch: 100,
 		wait:     5 * time.Millisecond,
 		fetch: makeMediaURLLoader(db, func(query *gorm.DB) *gorm.DB {
-			return query.Where("purpose = ? OR purpose = ?", models.VideoWeb, models.MediaOriginal)
+			// The last url of a media is used, so sort a transcoded video after the original
+			return query.Where("purpose = ? OR purpose = ?", models.VideoWeb, models.MediaOriginal).Order("purpose")
+		}),
+	}
+}
+
+func NewMotionVideoMediaURLLoader(db *gorm.DB) *MediaURLLoader {
+	return &MediaURLLoader{
+		maxBatch: 100,
+		wait:     5 * time.Millisecond,
+		fetch: makeMediaURLLoader(db, func(query *gorm.DB) *gorm.DB {
+			return query.Where("purpose = ?", models.MotionVideo)
+		}),
+	}
+}
+
+func NewVideoHLSMediaURLLoader(db *gorm.DB) *MediaURLLoader {
+	return &MediaURLLoader{
+		maxBatch: 100,
+		wait:     5 * time.Millisecond,
+		fetch: makeMediaURLLoader(db, func(query *gorm.DB) *gorm.DB {
+			return query.Where("purpose = ?", models.VideoHLS)
+		}),
+	}
+}
+
+func NewVideoPreviewMediaURLLoader(db *gorm.DB) *MediaURLLoader {
+	return &MediaURLLoader{
+		maxBatch: 100,
+		wait:     5 * time.Millisecond,
+		fetch: makeMediaURLLoader(db, func(query *gorm.DB) *gorm.DB {
+			return query.Where("purpose = ?", models.VideoPreview)
 		}),
 	}
 }
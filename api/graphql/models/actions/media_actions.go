@@ -1,16 +1,23 @@
 package actions
 
 import (
+	"log"
+	"os"
+
+	"github.com/photoview/photoview/api/database/drivers"
+	"github.com/photoview/photoview/api/graphql/auth"
 	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
-func MyMedia(db *gorm.DB, user *models.User, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+func MyMedia(db *gorm.DB, user *models.User, order *models.Ordering, paginate *models.Pagination, ratingFilter *models.MediaRatingFilter) ([]*models.Media, error) {
 	if err := user.FillAlbums(db); err != nil {
 		return nil, err
 	}
 
 	query := db.Where("media.album_id IN (SELECT user_albums.album_id FROM user_albums WHERE user_albums.user_id = ?)", user.ID)
+	query = models.FilterMediaByRating(query, user.ID, ratingFilter)
 	query = models.FormatSQL(query, order, paginate)
 
 	var media []*models.Media
@@ -20,3 +27,117 @@ func MyMedia(db *gorm.DB, user *models.User, order *models.Ordering, paginate *m
 
 	return media, nil
 }
+
+// ownedMediaQuery limits a query of media, joined with its album, to the media in albums owned by the user
+func ownedMediaQuery(db *gorm.DB, user *models.User) *gorm.DB {
+	var query string
+	if drivers.POSTGRES.MatchDatabase(db) {
+		query = "EXISTS (SELECT * FROM user_albums WHERE user_albums.album_id = \"Album\".id AND user_albums.user_id = ?)"
+	} else {
+		query = "EXISTS (SELECT * FROM user_albums WHERE user_albums.album_id = Album.id AND user_albums.user_id = ?)"
+	}
+
+	return db.Joins("Album").Where(query, user.ID)
+}
+
+// findOwnedMedia returns the media with the given id, if it belongs to an album owned by the user
+func findOwnedMedia(db *gorm.DB, user *models.User, mediaID int) (*models.Media, error) {
+	var media models.Media
+	err := ownedMediaQuery(db, user).
+		First(&media, mediaID).
+		Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, auth.ErrUnauthorized
+		} else {
+			return nil, errors.Wrap(err, "failed to validate media owner with database")
+		}
+	}
+
+	return &media, nil
+}
+
+// findOwnedMediaList returns the media with the given ids, if they all belong to albums owned by the user
+func findOwnedMediaList(db *gorm.DB, user *models.User, mediaIDs []int) ([]*models.Media, error) {
+	uniqueIDs := make(map[int]bool)
+	for _, id := range mediaIDs {
+		uniqueIDs[id] = true
+	}
+
+	var media []*models.Media
+	if err := ownedMediaQuery(db, user).Where("media.id IN (?)", mediaIDs).Find(&media).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to validate media owner with database")
+	}
+
+	if len(media) != len(uniqueIDs) {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return media, nil
+}
+
+// SetVideoPoster overrides the poster frame of a video with the frame at the given timestamp in seconds,
+// or resets it to be chosen automatically if the timestamp is nil.
+// The current thumbnail is removed, so that it will be generated again from the new poster frame.
+func SetVideoPoster(db *gorm.DB, user *models.User, mediaID int, timestamp *float64) (*models.Media, error) {
+	media, err := findOwnedMedia(db, user, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	if media.Type != models.MediaTypeVideo {
+		return nil, errors.New("poster frames can only be set for videos")
+	}
+
+	if timestamp != nil {
+		if *timestamp < 0 {
+			return nil, errors.New("poster timestamp can not be negative")
+		}
+
+		var videoMetadata models.VideoMetadata
+		if media.VideoMetadataID != nil {
+			if err := db.First(&videoMetadata, *media.VideoMetadataID).Error; err != nil {
+				return nil, errors.Wrap(err, "get video metadata from database")
+			}
+
+			if *timestamp > videoMetadata.Duration {
+				return nil, errors.Errorf("poster timestamp is after the end of the video (%.2f seconds)", videoMetadata.Duration)
+			}
+		}
+	}
+
+	var thumbnailURLs []*models.MediaURL
+	if err := db.Where("media_id = ? AND purpose = ?", media.ID, models.VideoThumbnail).Find(&thumbnailURLs).Error; err != nil {
+		return nil, errors.Wrap(err, "get video thumbnail from database")
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(media).Update("poster_timestamp", timestamp).Error; err != nil {
+			return errors.Wrap(err, "update poster timestamp of video")
+		}
+
+		for _, thumbnailURL := range thumbnailURLs {
+			if err := tx.Delete(thumbnailURL).Error; err != nil {
+				return errors.Wrap(err, "delete previous video thumbnail from database")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	media.PosterTimestamp = timestamp
+
+	for _, thumbnailURL := range thumbnailURLs {
+		thumbnailURL.Media = media
+		if cachedPath, err := thumbnailURL.CachedPath(); err == nil {
+			if err := os.Remove(cachedPath); err != nil && !os.IsNotExist(err) {
+				log.Printf("WARN: could not remove previous video thumbnail (%s): %s\n", cachedPath, err)
+			}
+		}
+	}
+
+	return media, nil
+}
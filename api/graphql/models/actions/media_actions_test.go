@@ -80,9 +80,84 @@ func TestMyMedia(t *testing.T) {
 	assert.NoError(t, db.Model(&anotherUser).Association("Albums").Append(&anotherAlbum))
 
 	t.Run("Simple query", func(t *testing.T) {
-		myMedia, err := actions.MyMedia(db, user, nil, nil)
+		myMedia, err := actions.MyMedia(db, user, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Len(t, myMedia, 4)
 	})
 }
+
+func TestSetVideoPoster(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	otherUser, err := models.RegisterUser(db, "other", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/videos",
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	videoMetadata := models.VideoMetadata{Width: 1920, Height: 1080, Duration: 60}
+	assert.NoError(t, db.Save(&videoMetadata).Error)
+
+	video := models.Media{
+		Title:           "video.mp4",
+		Path:            "/videos/video.mp4",
+		AlbumID:         album.ID,
+		Type:            models.MediaTypeVideo,
+		VideoMetadataID: &videoMetadata.ID,
+	}
+	photo := models.Media{
+		Title:   "photo.jpg",
+		Path:    "/videos/photo.jpg",
+		AlbumID: album.ID,
+		Type:    models.MediaTypePhoto,
+	}
+	assert.NoError(t, db.Save(&video).Error)
+	assert.NoError(t, db.Save(&photo).Error)
+
+	thumbnail := models.MediaURL{
+		MediaID:     video.ID,
+		MediaName:   "video_thumb.jpg",
+		Purpose:     models.VideoThumbnail,
+		ContentType: "image/jpeg",
+	}
+	assert.NoError(t, db.Save(&thumbnail).Error)
+
+	timestamp := 12.5
+
+	t.Run("Set poster timestamp", func(t *testing.T) {
+		media, err := actions.SetVideoPoster(db, user, video.ID, &timestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, &timestamp, media.PosterTimestamp)
+
+		var count int64
+		assert.NoError(t, db.Model(&models.MediaURL{}).Where("media_id = ? AND purpose = ?", video.ID, models.VideoThumbnail).Count(&count).Error)
+		assert.EqualValues(t, 0, count, "previous thumbnail should be removed")
+	})
+
+	t.Run("Reset poster timestamp", func(t *testing.T) {
+		media, err := actions.SetVideoPoster(db, user, video.ID, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, media.PosterTimestamp)
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		afterEnd := 90.0
+		_, err := actions.SetVideoPoster(db, user, video.ID, &afterEnd)
+		assert.Error(t, err)
+
+		_, err = actions.SetVideoPoster(db, user, photo.ID, &timestamp)
+		assert.Error(t, err)
+
+		_, err = actions.SetVideoPoster(db, otherUser, video.ID, &timestamp)
+		assert.Error(t, err)
+	})
+}
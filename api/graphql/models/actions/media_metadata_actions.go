@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// UpdateMediaMetadata sets the title and description of a media, values left as nil are not changed
+// and empty strings remove the value. The values are saved as overrides, so importing the metadata again keeps them.
+func UpdateMediaMetadata(db *gorm.DB, user *models.User, mediaID int, title *string, description *string) (*models.Media, error) {
+	media, err := findOwnedMedia(db, user, mediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	override := models.MediaOverride{
+		Title:       title,
+		Description: description,
+	}
+
+	columns := make([]string, 0)
+	if title != nil {
+		columns = append(columns, models.MediaOverrideTitleColumns...)
+	}
+	if description != nil {
+		columns = append(columns, models.MediaOverrideDescriptionColumns...)
+	}
+
+	if len(columns) == 0 {
+		return media, nil
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := exif.SaveMediaOverride(tx, media, &override, columns); err != nil {
+			return errors.Wrap(err, "update media metadata")
+		}
+
+		return writeMetadataToSidecar(tx, media, exif.XMPSidecarUpdate{
+			Title:       title,
+			Description: description,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// writeMetadataToSidecar writes the edited metadata to the XMP sidecar of the media, if XMP write-back is enabled.
+// The hash of the written sidecar is saved, so the scanner does not import the edits again.
+func writeMetadataToSidecar(tx *gorm.DB, media *models.Media, update exif.XMPSidecarUpdate) error {
+	if !utils.EnvXMPWriteBack.GetBool() {
+		return nil
+	}
+
+	sidecarPath, sidecarHash, err := exif.WriteXMPSidecar(media, update)
+	if err != nil {
+		return errors.Wrap(err, "write metadata to XMP sidecar")
+	}
+
+	err = tx.Model(media).UpdateColumns(map[string]interface{}{
+		"side_car_path": sidecarPath,
+		"side_car_hash": sidecarHash,
+	}).Error
+	if err != nil {
+		return errors.Wrap(err, "update media sidecar info")
+	}
+
+	media.SideCarPath = &sidecarPath
+	media.SideCarHash = &sidecarHash
+	return nil
+}
@@ -0,0 +1,121 @@
+package actions_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/auth"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/photoview/photoview/api/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMediaMetadata(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+	dir := t.TempDir()
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	otherUser, err := models.RegisterUser(db, "other", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  dir,
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	photo := models.Media{
+		Title:   "photo.jpg",
+		Path:    path.Join(dir, "photo.jpg"),
+		AlbumID: album.ID,
+		Type:    models.MediaTypePhoto,
+	}
+	assert.NoError(t, db.Save(&photo).Error)
+
+	title := "Sunset"
+	description := "At the beach"
+
+	t.Run("Set title and description", func(t *testing.T) {
+		media, err := actions.UpdateMediaMetadata(db, user, photo.ID, &title, &description)
+		if !assert.NoError(t, err) || !assert.NotNil(t, media.ExifID) {
+			return
+		}
+
+		var mediaExif models.MediaEXIF
+		assert.NoError(t, db.First(&mediaExif, *media.ExifID).Error)
+		assert.Equal(t, "Sunset", *mediaExif.Title)
+		assert.Equal(t, "At the beach", *mediaExif.Description)
+
+		assert.Nil(t, exif.FindXMPSidecar(photo.Path), "no sidecar is written without write-back enabled")
+	})
+
+	t.Run("Clear description", func(t *testing.T) {
+		empty := ""
+		media, err := actions.UpdateMediaMetadata(db, user, photo.ID, nil, &empty)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var mediaExif models.MediaEXIF
+		assert.NoError(t, db.First(&mediaExif, *media.ExifID).Error)
+		assert.Equal(t, "Sunset", *mediaExif.Title)
+		assert.Nil(t, mediaExif.Description)
+
+		override, err := models.FindMediaOverride(db, photo.ID)
+		if assert.NoError(t, err) && assert.NotNil(t, override) {
+			assert.Equal(t, "Sunset", *override.Title)
+			assert.Equal(t, "", *override.Description, "the removed description is kept as an override")
+		}
+	})
+
+	t.Run("Write back to sidecar", func(t *testing.T) {
+		t.Setenv(utils.EnvXMPWriteBack.GetName(), "true")
+
+		media, err := actions.UpdateMediaMetadata(db, user, photo.ID, &title, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		sidecarPath := photo.Path + ".xmp"
+		assert.Equal(t, &sidecarPath, media.SideCarPath)
+
+		content, err := os.ReadFile(sidecarPath)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, exif.HashXMPSidecar(content), *media.SideCarHash)
+		assert.Contains(t, string(content), "Sunset")
+
+		var storedMedia models.Media
+		assert.NoError(t, db.First(&storedMedia, photo.ID).Error)
+		assert.Equal(t, media.SideCarHash, storedMedia.SideCarHash)
+	})
+
+	t.Run("Refuse to overwrite external edits", func(t *testing.T) {
+		t.Setenv(utils.EnvXMPWriteBack.GetName(), "true")
+
+		sidecarPath := photo.Path + ".xmp"
+		assert.NoError(t, os.WriteFile(sidecarPath, []byte("edited elsewhere"), 0644))
+
+		newTitle := "Sunrise"
+		_, err := actions.UpdateMediaMetadata(db, user, photo.ID, &newTitle, nil)
+		assert.ErrorIs(t, err, exif.ErrXMPSidecarConflict)
+
+		var storedMedia models.Media
+		assert.NoError(t, db.Preload("Exif").First(&storedMedia, photo.ID).Error)
+		assert.Equal(t, "Sunset", *storedMedia.Exif.Title, "the edit is not saved when the sidecar can not be written")
+	})
+
+	t.Run("Media of another user", func(t *testing.T) {
+		_, err := actions.UpdateMediaMetadata(db, otherUser, photo.ID, &title, nil)
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	})
+}
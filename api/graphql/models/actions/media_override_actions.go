@@ -0,0 +1,202 @@
+package actions
+
+import (
+	"io"
+	"math"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/scanner/gpx"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// SetMediaDate overrides the capture date of each of the media. The date is stored as the local time
+// in the timezone of the given date, together with the offset of the timezone.
+func SetMediaDate(db *gorm.DB, user *models.User, mediaIDs []int, date time.Time) ([]*models.Media, error) {
+	localDate := time.Date(date.Year(), date.Month(), date.Day(), date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), time.UTC)
+	_, offset := date.Zone()
+
+	return overrideMedia(db, user, mediaIDs, models.MediaOverrideDateColumns, func(m *models.Media, override *models.MediaOverride) error {
+		override.DateShot = &localDate
+		override.DateShotOffset = &offset
+		return nil
+	})
+}
+
+// ShiftMediaDate moves the capture date of each of the media by the given number of seconds,
+// which is useful to correct the dates of a camera with a wrong clock
+func ShiftMediaDate(db *gorm.DB, user *models.User, mediaIDs []int, seconds int) ([]*models.Media, error) {
+	return overrideMedia(db, user, mediaIDs, models.MediaOverrideDateColumns, func(m *models.Media, override *models.MediaOverride) error {
+		shiftedDate := m.DateShot.Add(time.Duration(seconds) * time.Second)
+		override.DateShot = &shiftedDate
+		override.DateShotOffset = m.DateShotOffset
+		return nil
+	})
+}
+
+// SetMediaLocation overrides the coordinates of the place of capture of each of the media
+func SetMediaLocation(db *gorm.DB, user *models.User, mediaIDs []int, latitude float64, longitude float64) ([]*models.Media, error) {
+	if math.Abs(latitude) > 90 || math.Abs(longitude) > 180 {
+		return nil, errors.New("the latitude must be between -90 and 90 and the longitude between -180 and 180")
+	}
+
+	return overrideMedia(db, user, mediaIDs, models.MediaOverrideLocationColumns, func(m *models.Media, override *models.MediaOverride) error {
+		override.GPSLatitude = &latitude
+		override.GPSLongitude = &longitude
+		return nil
+	})
+}
+
+// CopyMediaLocation overrides the coordinates of each of the media with the coordinates of the source media
+func CopyMediaLocation(db *gorm.DB, user *models.User, mediaIDs []int, sourceMediaID int) ([]*models.Media, error) {
+	source, err := findOwnedMedia(db, user, sourceMediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceExif models.MediaEXIF
+	if source.ExifID != nil {
+		if err := db.First(&sourceExif, *source.ExifID).Error; err != nil {
+			return nil, errors.Wrap(err, "get metadata of source media from database")
+		}
+	}
+
+	coordinates := sourceExif.Coordinates()
+	if coordinates == nil {
+		return nil, errors.New("the source media has no location")
+	}
+
+	return SetMediaLocation(db, user, mediaIDs, coordinates.Latitude, coordinates.Longitude)
+}
+
+// ResetMediaOverrides removes the overridden date or location of each of the media,
+// and imports the values from the metadata of the media files again. The metadata is read after the overrides
+// are removed, outside of their transaction, as reading the files of many media takes long.
+func ResetMediaOverrides(db *gorm.DB, user *models.User, mediaIDs []int, date bool, location bool) ([]*models.Media, error) {
+	media, err := findOwnedMediaList(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, 0)
+	if date {
+		columns = append(columns, models.MediaOverrideDateColumns...)
+	}
+	if location {
+		columns = append(columns, models.MediaOverrideLocationColumns...)
+	}
+
+	if len(columns) == 0 {
+		return media, nil
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range media {
+			override, err := models.FindMediaOverride(tx, m.ID)
+			if err != nil {
+				return err
+			}
+			if override == nil {
+				continue
+			}
+
+			if date {
+				override.DateShot = nil
+				override.DateShotOffset = nil
+			}
+			if location {
+				override.GPSLatitude = nil
+				override.GPSLongitude = nil
+				override.LocationFromTrack = false
+			}
+
+			if override.IsEmpty() {
+				err = tx.Delete(override).Error
+			} else {
+				err = tx.Model(override).Select(columns).Updates(override).Error
+			}
+			if err != nil {
+				return errors.Wrap(err, "reset media overrides in database")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scanner.RefreshMetadata(db, media)
+
+	return media, nil
+}
+
+// overrideMedia sets the columns of the overrides of each of the media, and updates the date and location
+// shown for the media to the overridden values
+func overrideMedia(db *gorm.DB, user *models.User, mediaIDs []int, columns []string, setValue func(m *models.Media, override *models.MediaOverride) error) ([]*models.Media, error) {
+	media, err := findOwnedMediaList(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range media {
+			var override models.MediaOverride
+			if err := setValue(m, &override); err != nil {
+				return err
+			}
+
+			if err := exif.SaveMediaOverride(tx, m, &override, columns); err != nil {
+				return errors.Wrapf(err, "media %s", m.Path)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// GeotagAlbumFromGPX sets the location of the media of the album without coordinates from the GPX track.
+// The maximum gap and clock offset are given in seconds, the values of the environment are used when they are nil.
+func GeotagAlbumFromGPX(db *gorm.DB, user *models.User, albumID int, track io.Reader, maxGap *int, clockOffset *int) ([]*models.Media, error) {
+	album, err := Album(db, user, albumID)
+	if err != nil {
+		return nil, err
+	}
+
+	gpxTrack, err := gpx.Parse(track)
+	if err != nil {
+		return nil, err
+	}
+
+	options := gpx.DefaultOptions()
+	if maxGap != nil {
+		options.MaxGap = time.Duration(*maxGap) * time.Second
+	}
+	if clockOffset != nil {
+		options.ClockOffset = time.Duration(*clockOffset) * time.Second
+	}
+
+	var geotagged []*models.Media
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var media []*models.Media
+		if err := tx.Preload("Exif").Where("album_id = ?", album.ID).Find(&media).Error; err != nil {
+			return errors.Wrap(err, "get media of album")
+		}
+
+		geotagged, err = gpx.GeotagMedia(tx, media, gpxTrack, options)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return geotagged, nil
+}
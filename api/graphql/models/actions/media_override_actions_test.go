@@ -0,0 +1,204 @@
+package actions_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/auth"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaOverrides(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+	dir := t.TempDir()
+
+	exif.InitializeEXIFParser()
+
+	photoData, err := os.ReadFile("../../../scanner/exif/test_data/bird.jpg")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, os.WriteFile(path.Join(dir, "bird.jpg"), photoData, 0644))
+
+	strippedData, err := os.ReadFile("../../../scanner/exif/test_data/stripped.jpg")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, os.WriteFile(path.Join(dir, "no_metadata.jpg"), strippedData, 0644))
+
+	fileInfo, err := os.Stat(path.Join(dir, "no_metadata.jpg"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	fileDate := fileInfo.ModTime()
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	otherUser, err := models.RegisterUser(db, "other", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  dir,
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	photo := models.Media{
+		Title:   "bird.jpg",
+		Path:    path.Join(dir, "bird.jpg"),
+		AlbumID: album.ID,
+		Type:    models.MediaTypePhoto,
+	}
+	assert.NoError(t, db.Save(&photo).Error)
+
+	noMetadata := models.Media{
+		Title:   "no_metadata.jpg",
+		Path:    path.Join(dir, "no_metadata.jpg"),
+		AlbumID: album.ID,
+		Type:    models.MediaTypePhoto,
+	}
+	assert.NoError(t, db.Save(&noMetadata).Error)
+
+	parsedExif, err := exif.ReimportEXIF(db, &photo)
+	if !assert.NoError(t, err) || !assert.NotNil(t, parsedExif) {
+		return
+	}
+	parsedDate := *parsedExif.DateShot
+
+	reloadMedia := func(t *testing.T, mediaID int) (*models.Media, *models.MediaEXIF) {
+		var m models.Media
+		assert.NoError(t, db.Preload("Exif").First(&m, mediaID).Error)
+		return &m, m.Exif
+	}
+
+	reimport := func(t *testing.T) {
+		m, _ := reloadMedia(t, photo.ID)
+		_, err := exif.ReimportEXIF(db, m)
+		assert.NoError(t, err)
+	}
+
+	t.Run("Set date", func(t *testing.T) {
+		date := time.Date(2020, 7, 14, 18, 30, 0, 0, time.FixedZone("", 2*3600))
+		_, err := actions.SetMediaDate(db, user, []int{photo.ID, noMetadata.ID}, date)
+		assert.NoError(t, err)
+
+		for _, mediaID := range []int{photo.ID, noMetadata.ID} {
+			m, mediaExif := reloadMedia(t, mediaID)
+			assert.True(t, date.Equal(m.Date()))
+			assert.Equal(t, 2*3600, *m.DateShotOffset)
+			if assert.NotNil(t, mediaExif) {
+				assert.True(t, m.DateShot.Equal(*mediaExif.DateShot))
+			}
+		}
+
+		reimport(t)
+		m, _ := reloadMedia(t, photo.ID)
+		assert.True(t, date.Equal(m.Date()), "the overridden date is kept when the metadata is imported again")
+	})
+
+	t.Run("Shift date", func(t *testing.T) {
+		before, _ := reloadMedia(t, photo.ID)
+
+		_, err := actions.ShiftMediaDate(db, user, []int{photo.ID}, 2*3600+13*60)
+		assert.NoError(t, err)
+
+		m, _ := reloadMedia(t, photo.ID)
+		assert.Equal(t, 2*time.Hour+13*time.Minute, m.DateShot.Sub(before.DateShot))
+		assert.Equal(t, before.DateShotOffset, m.DateShotOffset)
+	})
+
+	t.Run("Set location", func(t *testing.T) {
+		_, err := actions.SetMediaLocation(db, user, []int{photo.ID}, 48.8584, 2.2945)
+		assert.NoError(t, err)
+
+		reimport(t)
+		_, mediaExif := reloadMedia(t, photo.ID)
+		assert.Equal(t, &models.Coordinates{Latitude: 48.8584, Longitude: 2.2945}, mediaExif.Coordinates())
+
+		_, err = actions.SetMediaLocation(db, user, []int{photo.ID}, 91, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("Copy location", func(t *testing.T) {
+		_, err := actions.CopyMediaLocation(db, user, []int{noMetadata.ID}, photo.ID)
+		assert.NoError(t, err)
+
+		_, mediaExif := reloadMedia(t, noMetadata.ID)
+		assert.Equal(t, &models.Coordinates{Latitude: 48.8584, Longitude: 2.2945}, mediaExif.Coordinates())
+
+		unlocated := models.Media{
+			Title:   "unlocated.jpg",
+			Path:    path.Join(dir, "unlocated.jpg"),
+			AlbumID: album.ID,
+			Type:    models.MediaTypePhoto,
+		}
+		assert.NoError(t, db.Save(&unlocated).Error)
+
+		_, err = actions.CopyMediaLocation(db, user, []int{photo.ID}, unlocated.ID)
+		assert.Error(t, err, "the source media must have a location")
+	})
+
+	t.Run("Reset overrides", func(t *testing.T) {
+		_, err := actions.ResetMediaOverrides(db, user, []int{photo.ID}, true, false)
+		assert.NoError(t, err)
+
+		m, mediaExif := reloadMedia(t, photo.ID)
+		assert.True(t, parsedDate.Equal(m.DateShot))
+		assert.Equal(t, &models.Coordinates{Latitude: 48.8584, Longitude: 2.2945}, mediaExif.Coordinates(), "the location is still overridden")
+
+		_, err = actions.ResetMediaOverrides(db, user, []int{noMetadata.ID}, true, true)
+		assert.NoError(t, err)
+
+		m, mediaExif = reloadMedia(t, noMetadata.ID)
+		assert.True(t, fileDate.Equal(m.DateShot), "media without a capture date are dated by their file")
+		assert.Nil(t, m.DateShotOffset)
+		if assert.NotNil(t, mediaExif) {
+			assert.Nil(t, mediaExif.DateShot)
+			assert.Nil(t, mediaExif.DateShotOffset)
+			assert.Nil(t, mediaExif.Coordinates())
+		}
+
+		_, err = actions.ResetMediaOverrides(db, user, []int{photo.ID}, false, true)
+		assert.NoError(t, err)
+
+		_, mediaExif = reloadMedia(t, photo.ID)
+		assert.InDelta(t, 65.0168, *mediaExif.GPSLatitude, 0.001)
+
+		override, err := models.FindMediaOverride(db, photo.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, override, "empty overrides are removed")
+	})
+
+	t.Run("Location from track replaced by the location of the file", func(t *testing.T) {
+		latitude, longitude := 60.0, 20.0
+		trackOverride := models.MediaOverride{
+			GPSLatitude:       &latitude,
+			GPSLongitude:      &longitude,
+			LocationFromTrack: true,
+		}
+		m, _ := reloadMedia(t, photo.ID)
+		assert.NoError(t, exif.SaveMediaOverride(db, m, &trackOverride, models.MediaOverrideLocationColumns))
+
+		reimport(t)
+		_, mediaExif := reloadMedia(t, photo.ID)
+		assert.InDelta(t, 65.0168, *mediaExif.GPSLatitude, 0.001)
+
+		override, err := models.FindMediaOverride(db, photo.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, override, "the location from the track is removed")
+	})
+
+	t.Run("Media of other users", func(t *testing.T) {
+		_, err := actions.ShiftMediaDate(db, otherUser, []int{photo.ID}, 60)
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,132 @@
+package actions
+
+import (
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SetMediaRating sets the star rating, from 0 to 5, given by the user to each of the media
+func SetMediaRating(db *gorm.DB, user *models.User, mediaIDs []int, stars int) ([]*models.Media, error) {
+	if stars < 0 || stars > 5 {
+		return nil, errors.New("the rating must be between 0 and 5 stars")
+	}
+
+	return setUserMediaRating(db, user, mediaIDs, "rating", func(data *models.UserMediaData) {
+		data.Rating = &stars
+	})
+}
+
+// SetMediaFlag picks or rejects each of the media for the user
+func SetMediaFlag(db *gorm.DB, user *models.User, mediaIDs []int, flag models.MediaFlag) ([]*models.Media, error) {
+	if !flag.IsValid() {
+		return nil, errors.Errorf("invalid media flag: %s", flag)
+	}
+
+	return setUserMediaRating(db, user, mediaIDs, "flag", func(data *models.UserMediaData) {
+		data.Flag = &flag
+	})
+}
+
+// SetMediaColorLabel sets the colour label given by the user to each of the media, a nil or empty label removes it
+func SetMediaColorLabel(db *gorm.DB, user *models.User, mediaIDs []int, colorLabel *string) ([]*models.Media, error) {
+	// An empty label is stored instead of nil, so the label from the metadata of the media is not used anymore
+	label := ""
+	if colorLabel != nil {
+		label = *colorLabel
+	}
+
+	return setUserMediaRating(db, user, mediaIDs, "color_label", func(data *models.UserMediaData) {
+		data.ColorLabel = &label
+	})
+}
+
+// setUserMediaRating sets a single column of the user media data of each media, and writes the new rating
+// to the XMP sidecars of the media if write-back is enabled and the rating is not only the one of the user
+func setUserMediaRating(db *gorm.DB, user *models.User, mediaIDs []int, column string, setValue func(data *models.UserMediaData)) ([]*models.Media, error) {
+	media, err := findOwnedMediaList(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range media {
+			userMediaData := models.UserMediaData{
+				UserID:  user.ID,
+				MediaID: m.ID,
+			}
+			setValue(&userMediaData)
+
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "media_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{column, "updated_at"}),
+			}).Create(&userMediaData).Error
+			if err != nil {
+				return errors.Wrapf(err, "update user %s of media in database", column)
+			}
+
+			writeBack, err := ratingSharedBySidecar(tx, user, m)
+			if err != nil {
+				return err
+			}
+			if !writeBack {
+				continue
+			}
+
+			rating, err := m.RatingForUser(tx, user)
+			if err != nil {
+				return err
+			}
+
+			if err := writeMetadataToSidecar(tx, m, ratingSidecarUpdate(column, rating)); err != nil {
+				return errors.Wrapf(err, "media %s", m.Path)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// ratingSharedBySidecar tells if the rating of the user is written to the XMP sidecar of the media.
+// Ratings are given per user, while the sidecar is shared by all users as the rating found in the metadata,
+// so only the ratings of admins and of the only user of the album of the media are written back.
+func ratingSharedBySidecar(tx *gorm.DB, user *models.User, media *models.Media) (bool, error) {
+	if user.Admin {
+		return true, nil
+	}
+
+	var otherUsers int64
+	err := tx.Table("user_albums").
+		Where("album_id = ? AND user_id != ?", media.AlbumID, user.ID).
+		Count(&otherUsers).Error
+	if err != nil {
+		return false, errors.Wrap(err, "count users of album")
+	}
+
+	return otherUsers == 0, nil
+}
+
+// ratingSidecarUpdate returns the XMP properties that represent the changed column of the rating,
+// rejected media are written with a rating of -1
+func ratingSidecarUpdate(column string, rating *models.MediaRating) exif.XMPSidecarUpdate {
+	if column == "color_label" {
+		label := ""
+		if rating.ColorLabel != nil {
+			label = *rating.ColorLabel
+		}
+		return exif.XMPSidecarUpdate{ColorLabel: &label}
+	}
+
+	stars := int64(rating.Stars)
+	if rating.Flag == models.MediaFlagReject {
+		stars = -1
+	}
+	return exif.XMPSidecarUpdate{Rating: &stars}
+}
@@ -0,0 +1,172 @@
+package actions_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/auth"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/photoview/photoview/api/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaRating(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	otherUser, err := models.RegisterUser(db, "other", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/photos",
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+	assert.NoError(t, db.Model(&otherUser).Association("Albums").Append(&album))
+
+	threeStars := int64(3)
+	rejected := int64(-1)
+	red := "red"
+
+	media := []*models.Media{
+		{
+			Title:   "rated.jpg",
+			Path:    "/photos/rated.jpg",
+			AlbumID: album.ID,
+			Exif:    &models.MediaEXIF{Rating: &threeStars, ColorLabel: &red},
+		},
+		{
+			Title:   "rejected.jpg",
+			Path:    "/photos/rejected.jpg",
+			AlbumID: album.ID,
+			Exif:    &models.MediaEXIF{Rating: &rejected},
+		},
+		{
+			Title:   "unrated.jpg",
+			Path:    "/photos/unrated.jpg",
+			AlbumID: album.ID,
+		},
+	}
+	assert.NoError(t, db.Save(&media).Error)
+
+	ratingOf := func(t *testing.T, mediaIndex int, user *models.User) *models.MediaRating {
+		var m models.Media
+		assert.NoError(t, db.First(&m, media[mediaIndex].ID).Error)
+
+		rating, err := m.RatingForUser(db, user)
+		assert.NoError(t, err)
+		return rating
+	}
+
+	orderByTitle := "title"
+	filteredTitles := func(t *testing.T, user *models.User, filter models.MediaRatingFilter) []string {
+		result, err := actions.MyMedia(db, user, &models.Ordering{OrderBy: &orderByTitle}, nil, &filter)
+		assert.NoError(t, err)
+
+		titles := make([]string, 0)
+		for _, m := range result {
+			titles = append(titles, m.Title)
+		}
+		return titles
+	}
+
+	t.Run("Seeded from metadata", func(t *testing.T) {
+		assert.Equal(t, &models.MediaRating{Stars: 3, Flag: models.MediaFlagUnflagged, ColorLabel: &red}, ratingOf(t, 0, user))
+		assert.Equal(t, &models.MediaRating{Stars: 0, Flag: models.MediaFlagReject}, ratingOf(t, 1, user))
+		assert.Equal(t, &models.MediaRating{Stars: 0, Flag: models.MediaFlagUnflagged}, ratingOf(t, 2, nil))
+
+		minStars := 1
+		assert.Equal(t, []string{"rated.jpg"}, filteredTitles(t, user, models.MediaRatingFilter{MinStars: &minStars}))
+
+		reject := models.MediaFlagReject
+		assert.Equal(t, []string{"rejected.jpg"}, filteredTitles(t, user, models.MediaRatingFilter{Flag: &reject}))
+
+		upperRed := "Red"
+		assert.Equal(t, []string{"rated.jpg"}, filteredTitles(t, user, models.MediaRatingFilter{ColorLabel: &upperRed}))
+	})
+
+	t.Run("Set many at once", func(t *testing.T) {
+		_, err := actions.SetMediaRating(db, user, []int{media[1].ID, media[2].ID}, 5)
+		assert.NoError(t, err)
+
+		_, err = actions.SetMediaFlag(db, user, []int{media[1].ID}, models.MediaFlagPick)
+		assert.NoError(t, err)
+
+		_, err = actions.SetMediaColorLabel(db, user, []int{media[0].ID}, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, &models.MediaRating{Stars: 3, Flag: models.MediaFlagUnflagged}, ratingOf(t, 0, user))
+		assert.Equal(t, &models.MediaRating{Stars: 5, Flag: models.MediaFlagPick}, ratingOf(t, 1, user))
+		assert.Equal(t, &models.MediaRating{Stars: 5, Flag: models.MediaFlagUnflagged}, ratingOf(t, 2, user))
+
+		minStars := 4
+		assert.Equal(t, []string{"rejected.jpg", "unrated.jpg"}, filteredTitles(t, user, models.MediaRatingFilter{MinStars: &minStars}))
+
+		pick := models.MediaFlagPick
+		assert.Equal(t, []string{"rejected.jpg"}, filteredTitles(t, user, models.MediaRatingFilter{Flag: &pick}))
+
+		assert.Empty(t, filteredTitles(t, user, models.MediaRatingFilter{ColorLabel: &red}))
+	})
+
+	t.Run("Ratings are per user", func(t *testing.T) {
+		assert.Equal(t, &models.MediaRating{Stars: 0, Flag: models.MediaFlagReject}, ratingOf(t, 1, otherUser))
+
+		minStars := 4
+		assert.Empty(t, filteredTitles(t, otherUser, models.MediaRatingFilter{MinStars: &minStars}))
+	})
+
+	t.Run("Favorite keeps the rating", func(t *testing.T) {
+		_, err := user.FavoriteMedia(db, media[2].ID, true)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 5, ratingOf(t, 2, user).Stars)
+	})
+
+	t.Run("Write back only ratings not given per user", func(t *testing.T) {
+		t.Setenv(utils.EnvXMPWriteBack.GetName(), "true")
+		dir := t.TempDir()
+
+		sharedAlbum := models.Album{
+			Title: "shared",
+			Path:  dir,
+		}
+		assert.NoError(t, db.Save(&sharedAlbum).Error)
+		assert.NoError(t, db.Model(&user).Association("Albums").Append(&sharedAlbum))
+		assert.NoError(t, db.Model(&otherUser).Association("Albums").Append(&sharedAlbum))
+
+		photo := models.Media{
+			Title:   "photo.jpg",
+			Path:    path.Join(dir, "photo.jpg"),
+			AlbumID: sharedAlbum.ID,
+		}
+		assert.NoError(t, db.Save(&photo).Error)
+
+		_, err := actions.SetMediaRating(db, user, []int{photo.ID}, 4)
+		assert.NoError(t, err)
+		assert.Nil(t, exif.FindXMPSidecar(photo.Path), "the rating of one of the users of the album is not written back")
+
+		assert.NoError(t, db.Model(&otherUser).Association("Albums").Delete(&sharedAlbum))
+
+		_, err = actions.SetMediaRating(db, user, []int{photo.ID}, 4)
+		assert.NoError(t, err)
+		assert.NotNil(t, exif.FindXMPSidecar(photo.Path), "the rating of the only user of the album is written back")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		_, err := actions.SetMediaRating(db, user, []int{media[0].ID}, 6)
+		assert.Error(t, err)
+
+		_, err = actions.SetMediaFlag(db, user, []int{media[0].ID}, models.MediaFlag("Maybe"))
+		assert.Error(t, err)
+
+		_, err = actions.SetMediaRating(db, user, []int{media[0].ID, -1}, 2)
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"sort"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MyPlaces returns the countries where the media of the user were taken, with the regions and cities of each country
+func MyPlaces(db *gorm.DB, user *models.User) ([]*models.Place, error) {
+	var rows []struct {
+		Country    string
+		Region     *string
+		City       *string
+		MediaCount int
+	}
+
+	err := db.Table("media").
+		Select("media_exif.country, media_exif.region, media_exif.city, COUNT(*) AS media_count").
+		Joins("JOIN media_exif ON media_exif.id = media.exif_id").
+		Where("media.album_id IN (SELECT user_albums.album_id FROM user_albums WHERE user_albums.user_id = ?)", user.ID).
+		Where("media_exif.country IS NOT NULL").
+		Group("media_exif.country, media_exif.region, media_exif.city").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "get places of user media")
+	}
+
+	countries := make([]*models.Place, 0)
+	countryByName := make(map[string]*models.Place)
+	regionByName := make(map[[2]string]*models.Place)
+
+	for _, row := range rows {
+		country, found := countryByName[row.Country]
+		if !found {
+			country = &models.Place{
+				Name:     row.Country,
+				Country:  row.Country,
+				Children: []*models.Place{},
+			}
+			countryByName[row.Country] = country
+			countries = append(countries, country)
+		}
+		country.MediaCount += row.MediaCount
+
+		parent := country
+		if row.Region != nil {
+			key := [2]string{row.Country, *row.Region}
+			region, found := regionByName[key]
+			if !found {
+				region = &models.Place{
+					Name:     *row.Region,
+					Country:  row.Country,
+					Region:   row.Region,
+					Children: []*models.Place{},
+				}
+				regionByName[key] = region
+				country.Children = append(country.Children, region)
+			}
+			region.MediaCount += row.MediaCount
+			parent = region
+		}
+
+		if row.City != nil {
+			parent.Children = append(parent.Children, &models.Place{
+				Name:       *row.City,
+				Country:    row.Country,
+				Region:     row.Region,
+				City:       row.City,
+				MediaCount: row.MediaCount,
+				Children:   []*models.Place{},
+			})
+		}
+	}
+
+	sortPlaces(countries)
+	return countries, nil
+}
+
+func sortPlaces(places []*models.Place) {
+	sort.Slice(places, func(i, j int) bool {
+		return places[i].Name < places[j].Name
+	})
+
+	for _, place := range places {
+		sortPlaces(place.Children)
+	}
+}
+
+// MediaByPlace returns the media of the user taken in the country, and in the region and city if they are given
+func MediaByPlace(db *gorm.DB, user *models.User, country string, region *string, city *string, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	exifQuery := db.Table("media_exif").Select("media_exif.id").Where("media_exif.country = ?", country)
+	if region != nil {
+		exifQuery = exifQuery.Where("media_exif.region = ?", *region)
+	}
+	if city != nil {
+		exifQuery = exifQuery.Where("media_exif.city = ?", *city)
+	}
+
+	query := db.
+		Where("media.album_id IN (SELECT user_albums.album_id FROM user_albums WHERE user_albums.user_id = ?)", user.ID).
+		Where("media.exif_id IN (?)", exifQuery)
+	query = models.FormatSQL(query, order, paginate)
+
+	var media []*models.Media
+	if err := query.Find(&media).Error; err != nil {
+		return nil, errors.Wrapf(err, "get media of place %s", country)
+	}
+
+	return media, nil
+}
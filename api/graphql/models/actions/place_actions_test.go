@@ -0,0 +1,95 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaces(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	otherUser, err := models.RegisterUser(db, "other", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/photos",
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	italy, latium, rome, tivoli := "Italy", "Latium", "Rome", "Tivoli"
+	fiji, waiyevo := "Fiji", "Waiyevo"
+
+	media := []*models.Media{
+		{Title: "colosseum.jpg", Exif: &models.MediaEXIF{Country: &italy, Region: &latium, City: &rome}},
+		{Title: "forum.jpg", Exif: &models.MediaEXIF{Country: &italy, Region: &latium, City: &rome}},
+		{Title: "villa.jpg", Exif: &models.MediaEXIF{Country: &italy, Region: &latium, City: &tivoli}},
+		{Title: "beach.jpg", Exif: &models.MediaEXIF{Country: &fiji, City: &waiyevo}},
+		{Title: "unknown.jpg"},
+	}
+	for _, m := range media {
+		m.Path = "/photos/" + m.Title
+		m.AlbumID = album.ID
+	}
+	assert.NoError(t, db.Save(&media).Error)
+
+	t.Run("Place hierarchy", func(t *testing.T) {
+		places, err := actions.MyPlaces(db, user)
+		if !assert.NoError(t, err) || !assert.Len(t, places, 2) {
+			return
+		}
+
+		assert.Equal(t, &models.Place{
+			Name:       "Fiji",
+			Country:    "Fiji",
+			MediaCount: 1,
+			Children: []*models.Place{
+				{Name: "Waiyevo", Country: "Fiji", City: &waiyevo, MediaCount: 1, Children: []*models.Place{}},
+			},
+		}, places[0], "cities without a region are children of the country")
+
+		assert.Equal(t, "Italy", places[1].Name)
+		assert.Equal(t, 3, places[1].MediaCount)
+		if assert.Len(t, places[1].Children, 1) {
+			region := places[1].Children[0]
+			assert.Equal(t, "Latium", region.Name)
+			assert.Equal(t, 3, region.MediaCount)
+			if assert.Len(t, region.Children, 2) {
+				assert.Equal(t, "Rome", region.Children[0].Name)
+				assert.Equal(t, 2, region.Children[0].MediaCount)
+				assert.Equal(t, "Tivoli", region.Children[1].Name)
+			}
+		}
+
+		places, err = actions.MyPlaces(db, otherUser)
+		assert.NoError(t, err)
+		assert.Empty(t, places)
+	})
+
+	t.Run("Media of place", func(t *testing.T) {
+		mediaTitles := func(country string, region *string, city *string) []string {
+			orderBy := "title"
+			result, err := actions.MediaByPlace(db, user, country, region, city, &models.Ordering{OrderBy: &orderBy}, nil)
+			assert.NoError(t, err)
+
+			titles := make([]string, 0)
+			for _, m := range result {
+				titles = append(titles, m.Title)
+			}
+			return titles
+		}
+
+		assert.Equal(t, []string{"colosseum.jpg", "forum.jpg", "villa.jpg"}, mediaTitles("Italy", nil, nil))
+		assert.Equal(t, []string{"colosseum.jpg", "forum.jpg"}, mediaTitles("Italy", &latium, &rome))
+		assert.Empty(t, mediaTitles("Portugal", nil, nil))
+	})
+}
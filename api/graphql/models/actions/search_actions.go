@@ -10,7 +10,7 @@ import (
 	"gorm.io/gorm/clause"
 )
 
-func Search(db *gorm.DB, query string, userID int, _limitMedia *int, _limitAlbums *int) (*models.SearchResult, error) {
+func Search(db *gorm.DB, query string, userID int, _limitMedia *int, _limitAlbums *int, ratingFilter *models.MediaRatingFilter) (*models.SearchResult, error) {
 	limitMedia := 10
 	limitAlbums := 10
 
@@ -33,12 +33,17 @@ func Search(db *gorm.DB, query string, userID int, _limitMedia *int, _limitAlbum
 		userSubquery = userSubquery.Where("album_id = Album.id")
 	}
 
-	err := db.Joins("Album").
+	mediaQuery := models.FilterMediaByRating(db.Joins("Album"), userID, ratingFilter)
+
+	// Media are also found by the names of the place where they were taken, after the media matching by name
+	err := mediaQuery.
+		Joins("LEFT JOIN media_exif ON media_exif.id = media.exif_id").
 		Where("EXISTS (?)", userSubquery).
-		Where("LOWER(media.title) LIKE ? OR LOWER(media.path) LIKE ?", wildQuery, wildQuery).
+		Where("LOWER(media.title) LIKE ? OR LOWER(media.path) LIKE ? OR LOWER(media_exif.city) LIKE ? OR LOWER(media_exif.region) LIKE ? OR LOWER(media_exif.country) LIKE ?",
+			wildQuery, wildQuery, wildQuery, wildQuery, wildQuery).
 		Clauses(clause.OrderBy{
 			Expression: clause.Expr{
-				SQL:                "(CASE WHEN LOWER(media.title) LIKE ? THEN 2 WHEN LOWER(media.path) LIKE ? THEN 1 END) DESC",
+				SQL:                "(CASE WHEN LOWER(media.title) LIKE ? THEN 2 WHEN LOWER(media.path) LIKE ? THEN 1 ELSE 0 END) DESC",
 				Vars:               []interface{}{wildQuery, wildQuery},
 				WithoutParentheses: true},
 		}).
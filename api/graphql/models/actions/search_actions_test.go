@@ -58,6 +58,15 @@ func TestSearch(t *testing.T) {
 		assert.NoError(t, db.Create(&image).Error)
 	}
 
+	city, region, country := "Lisbon", "Lisbon", "Portugal"
+	harbour := models.Media{
+		Title:   "harbour.jpg",
+		Path:    "/media/harbour.jpg",
+		AlbumID: rootAlbum.ID,
+		Exif:    &models.MediaEXIF{City: &city, Region: &region, Country: &country},
+	}
+	assert.NoError(t, db.Create(&harbour).Error)
+
 	type SearchTest = struct {
 		query      string
 		userID     int
@@ -81,6 +90,12 @@ func TestSearch(t *testing.T) {
 			expectedMediaCount: 10,
 			expectedAlbumCount: 0,
 		},
+		{
+			query:              "lisbon",
+			userID:             user.ID,
+			expectedMediaCount: 1,
+			expectedAlbumCount: 0,
+		},
 		{
 			query:              "media",
 			userID:             user.ID,
@@ -91,7 +106,7 @@ func TestSearch(t *testing.T) {
 
 	for _, test := range searchTests {
 		t.Run(fmt.Sprintf("Search query: '%s'", test.query), func(t *testing.T) {
-			result, err := actions.Search(db, test.query, test.userID, test.limitMedia, test.limitAlbum)
+			result, err := actions.Search(db, test.query, test.userID, test.limitMedia, test.limitAlbum, nil)
 			assert.NoError(t, err)
 
 			assert.Equal(t, result.Query, test.query)
@@ -0,0 +1,295 @@
+package actions
+
+import (
+	"log"
+	"strings"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userTagsCondition selects the tags of the media owned by the user
+const userTagsCondition = "tags.id IN (SELECT media_tags.tag_id FROM media_tags JOIN media ON media.id = media_tags.media_id " +
+	"WHERE media.album_id IN (SELECT user_albums.album_id FROM user_albums WHERE user_albums.user_id = ?))"
+
+// MyTags returns the tags of the media owned by the user, together with the parents of those tags
+func MyTags(db *gorm.DB, user *models.User) ([]*models.Tag, error) {
+	var tags []*models.Tag
+	err := db.
+		Where(userTagsCondition, user.ID).
+		Find(&tags).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "get tags of user media")
+	}
+
+	foundPaths := make(map[string]bool)
+	for _, tag := range tags {
+		foundPaths[tag.Path] = true
+	}
+
+	parentHashes := make([]string, 0)
+	for _, tag := range tags {
+		names := strings.Split(tag.Path, models.TagPathSeparator)
+		for i := 1; i < len(names); i++ {
+			path := strings.Join(names[:i], models.TagPathSeparator)
+			if !foundPaths[path] {
+				foundPaths[path] = true
+				parentHashes = append(parentHashes, models.MD5Hash(path))
+			}
+		}
+	}
+
+	if len(parentHashes) > 0 {
+		var parents []*models.Tag
+		if err := db.Where("path_hash IN (?)", parentHashes).Find(&parents).Error; err != nil {
+			return nil, errors.Wrap(err, "get parents of user tags")
+		}
+		tags = append(tags, parents...)
+	}
+
+	return tags, nil
+}
+
+// TagChildren returns the tags directly below the tag, that are either tags of the media owned by the user
+// or parents of such tags
+func TagChildren(db *gorm.DB, user *models.User, tag *models.Tag) ([]*models.Tag, error) {
+	descendants, err := tag.Descendants(db.Where(userTagsCondition, user.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := tag.Path + models.TagPathSeparator
+	childHashes := make([]string, 0)
+	foundPaths := make(map[string]bool)
+	for _, descendant := range descendants {
+		childName := strings.SplitN(strings.TrimPrefix(descendant.Path, prefix), models.TagPathSeparator, 2)[0]
+		childPath := prefix + childName
+		if !foundPaths[childPath] {
+			foundPaths[childPath] = true
+			childHashes = append(childHashes, models.MD5Hash(childPath))
+		}
+	}
+
+	children := make([]*models.Tag, 0)
+	if len(childHashes) == 0 {
+		return children, nil
+	}
+
+	if err := db.Where("path_hash IN (?)", childHashes).Order("name").Find(&children).Error; err != nil {
+		return nil, errors.Wrapf(err, "get children of tag %s", tag.Path)
+	}
+
+	return children, nil
+}
+
+// MediaByTag returns the media owned by the user with the given tag, or with any tag nested below it if includeChildren is set
+func MediaByTag(db *gorm.DB, user *models.User, tagID int, includeChildren bool, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	var tag models.Tag
+	if err := db.First(&tag, tagID).Error; err != nil {
+		return nil, errors.Wrap(err, "get tag from database")
+	}
+
+	tagIDs := []int{tag.ID}
+	if includeChildren {
+		descendants, err := tag.Descendants(db)
+		if err != nil {
+			return nil, err
+		}
+		for _, descendant := range descendants {
+			tagIDs = append(tagIDs, descendant.ID)
+		}
+	}
+
+	query := db.
+		Where("media.album_id IN (SELECT user_albums.album_id FROM user_albums WHERE user_albums.user_id = ?)", user.ID).
+		Where("media.id IN (SELECT media_tags.media_id FROM media_tags WHERE media_tags.tag_id IN (?))", tagIDs)
+	query = models.FormatSQL(query, order, paginate)
+
+	var media []*models.Media
+	if err := query.Find(&media).Error; err != nil {
+		return nil, errors.Wrapf(err, "get media of tag %s", tag.Path)
+	}
+
+	return media, nil
+}
+
+// AddMediaTag adds the tag with the given path to each of the media, the tag and its parents are created if they do not exist
+func AddMediaTag(db *gorm.DB, user *models.User, mediaIDs []int, tagPath string) ([]*models.Media, error) {
+	names := models.SplitTagPath(tagPath)
+	if len(names) == 0 {
+		return nil, errors.New("a tag must have a name")
+	}
+
+	media, err := findOwnedMediaList(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		tag, err := models.FindOrCreateTag(tx, names)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range media {
+			mediaTag := models.MediaTag{
+				MediaID: m.ID,
+				TagID:   tag.ID,
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&mediaTag).Error; err != nil {
+				return errors.Wrapf(err, "add tag %s to media", tag.Path)
+			}
+
+			if err := writeTagsToSidecar(tx, m); err != nil {
+				return errors.Wrapf(err, "media %s", m.Path)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// RemoveMediaTag removes the tag from each of the media
+func RemoveMediaTag(db *gorm.DB, user *models.User, mediaIDs []int, tagID int) ([]*models.Media, error) {
+	media, err := findOwnedMediaList(db, user, mediaIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range media {
+			if err := tx.Where("media_id = ? AND tag_id = ?", m.ID, tagID).Delete(&models.MediaTag{}).Error; err != nil {
+				return errors.Wrap(err, "remove tag from media")
+			}
+
+			if err := writeTagsToSidecar(tx, m); err != nil {
+				return errors.Wrapf(err, "media %s", m.Path)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// RenameTag changes the name of a tag, the tags nested below it keep their place below the renamed tag
+func RenameTag(db *gorm.DB, tagID int, name string) (*models.Tag, error) {
+	var tag models.Tag
+	if err := db.First(&tag, tagID).Error; err != nil {
+		return nil, errors.Wrap(err, "get tag from database")
+	}
+
+	affectedMedia, err := mediaOfTagTree(db, &tag)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error { return tag.Rename(tx, name) }); err != nil {
+		return nil, err
+	}
+
+	rewriteTagSidecars(db, affectedMedia)
+	return &tag, nil
+}
+
+// MergeTags moves the media and nested tags of the source tag to the target tag, and deletes the source tag
+func MergeTags(db *gorm.DB, sourceTagID int, targetTagID int) (*models.Tag, error) {
+	var source, target models.Tag
+	if err := db.First(&source, sourceTagID).Error; err != nil {
+		return nil, errors.Wrap(err, "get source tag from database")
+	}
+	if err := db.First(&target, targetTagID).Error; err != nil {
+		return nil, errors.Wrap(err, "get target tag from database")
+	}
+
+	affectedMedia, err := mediaOfTagTree(db, &source)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error { return source.MergeInto(tx, &target) }); err != nil {
+		return nil, err
+	}
+
+	rewriteTagSidecars(db, affectedMedia)
+	return &target, nil
+}
+
+// mediaOfTagTree returns the media with the tag or any of the tags nested below it
+func mediaOfTagTree(db *gorm.DB, tag *models.Tag) ([]*models.Media, error) {
+	descendants, err := tag.Descendants(db)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := []int{tag.ID}
+	for _, descendant := range descendants {
+		tagIDs = append(tagIDs, descendant.ID)
+	}
+
+	var media []*models.Media
+	err = db.Where("id IN (SELECT media_id FROM media_tags WHERE tag_id IN (?))", tagIDs).Find(&media).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "get media of tag %s", tag.Path)
+	}
+
+	return media, nil
+}
+
+// rewriteTagSidecars writes the tags of each media to its XMP sidecar after a tag was renamed or merged.
+// The tags have already been changed for all media, so failures are only logged.
+func rewriteTagSidecars(db *gorm.DB, media []*models.Media) {
+	for _, m := range media {
+		if err := writeTagsToSidecar(db, m); err != nil {
+			log.Printf("WARN: could not write tags of media %s to XMP sidecar: %s\n", m.Path, err)
+		}
+	}
+}
+
+// writeTagsToSidecar writes all tags of the media to its XMP sidecar, if XMP write-back is enabled.
+// Every level of a nested tag is written as a keyword, and the full paths of nested tags as hierarchical keywords.
+func writeTagsToSidecar(tx *gorm.DB, media *models.Media) error {
+	var tags []*models.Tag
+	err := tx.
+		Where("id IN (SELECT tag_id FROM media_tags WHERE media_id = ?)", media.ID).
+		Order("path").
+		Find(&tags).Error
+	if err != nil {
+		return errors.Wrap(err, "get tags of media")
+	}
+
+	keywords := make([]string, 0)
+	hierarchicalKeywords := make([]string, 0)
+	seenKeywords := make(map[string]bool)
+
+	for _, tag := range tags {
+		names := strings.Split(tag.Path, models.TagPathSeparator)
+		for _, name := range names {
+			if !seenKeywords[name] {
+				seenKeywords[name] = true
+				keywords = append(keywords, name)
+			}
+		}
+
+		if len(names) > 1 {
+			hierarchicalKeywords = append(hierarchicalKeywords, tag.Path)
+		}
+	}
+
+	return writeMetadataToSidecar(tx, media, exif.XMPSidecarUpdate{
+		Keywords:             &keywords,
+		HierarchicalKeywords: &hierarchicalKeywords,
+	})
+}
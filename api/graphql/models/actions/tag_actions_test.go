@@ -0,0 +1,176 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/auth"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTags(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+
+	otherUser, err := models.RegisterUser(db, "other", &password, false)
+	assert.NoError(t, err)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/photos",
+	}
+	assert.NoError(t, db.Save(&album).Error)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&album))
+
+	media := []*models.Media{
+		{
+			Title:   "paris.jpg",
+			Path:    "/photos/paris.jpg",
+			AlbumID: album.ID,
+		},
+		{
+			Title:   "lyon.jpg",
+			Path:    "/photos/lyon.jpg",
+			AlbumID: album.ID,
+		},
+	}
+	assert.NoError(t, db.Save(&media).Error)
+
+	tagPaths := func(t *testing.T, tags []*models.Tag) []string {
+		paths := make([]string, 0)
+		for _, tag := range tags {
+			paths = append(paths, tag.Path)
+		}
+		return paths
+	}
+
+	mediaTagPaths := func(t *testing.T, mediaIndex int) []string {
+		var tags []*models.Tag
+		err := db.Where("id IN (SELECT tag_id FROM media_tags WHERE media_id = ?)", media[mediaIndex].ID).
+			Order("path").Find(&tags).Error
+		assert.NoError(t, err)
+		return tagPaths(t, tags)
+	}
+
+	findTag := func(t *testing.T, path string) *models.Tag {
+		var tag models.Tag
+		assert.NoError(t, db.Where("path_hash = ?", models.MD5Hash(path)).First(&tag).Error)
+		return &tag
+	}
+
+	mediaTitles := func(t *testing.T, tagPath string, includeChildren bool) []string {
+		orderByTitle := "title"
+		result, err := actions.MediaByTag(db, user, findTag(t, tagPath).ID, includeChildren, &models.Ordering{OrderBy: &orderByTitle}, nil)
+		assert.NoError(t, err)
+
+		titles := make([]string, 0)
+		for _, m := range result {
+			titles = append(titles, m.Title)
+		}
+		return titles
+	}
+
+	t.Run("Import tags", func(t *testing.T) {
+		err := models.ReplaceImportedTags(db, media[0].ID, [][]string{{"Places", "France", "Paris"}, {"holiday"}})
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"Places|France|Paris", "holiday"}, mediaTagPaths(t, 0))
+		assert.Equal(t, findTag(t, "Places|France").ID, *findTag(t, "Places|France|Paris").ParentID)
+	})
+
+	t.Run("Add and remove tags", func(t *testing.T) {
+		_, err := actions.AddMediaTag(db, user, []int{media[0].ID, media[1].ID}, "Places | France | Lyon")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Places|France|Lyon", "Places|France|Paris", "holiday"}, mediaTagPaths(t, 0))
+
+		_, err = actions.RemoveMediaTag(db, user, []int{media[0].ID}, findTag(t, "Places|France|Lyon").ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Places|France|Paris", "holiday"}, mediaTagPaths(t, 0))
+		assert.Equal(t, []string{"Places|France|Lyon"}, mediaTagPaths(t, 1))
+
+		_, err = actions.AddMediaTag(db, otherUser, []int{media[0].ID}, "private")
+		assert.ErrorIs(t, err, auth.ErrUnauthorized)
+	})
+
+	t.Run("Imported tags are replaced, added tags are kept", func(t *testing.T) {
+		err := models.ReplaceImportedTags(db, media[1].ID, [][]string{{"imported"}})
+		assert.NoError(t, err)
+		err = models.ReplaceImportedTags(db, media[1].ID, [][]string{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"Places|France|Lyon"}, mediaTagPaths(t, 1))
+	})
+
+	t.Run("Browse tags", func(t *testing.T) {
+		tags, err := actions.MyTags(db, user)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t,
+			[]string{"Places", "Places|France", "Places|France|Lyon", "Places|France|Paris", "holiday"},
+			tagPaths(t, tags))
+
+		tags, err = actions.MyTags(db, otherUser)
+		assert.NoError(t, err)
+		assert.Empty(t, tags)
+
+		assert.Empty(t, mediaTitles(t, "Places|France", false))
+		assert.Equal(t, []string{"lyon.jpg", "paris.jpg"}, mediaTitles(t, "Places|France", true))
+	})
+
+	t.Run("Rename tag", func(t *testing.T) {
+		_, err := actions.RenameTag(db, findTag(t, "Places|France").ID, "Lyon")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Places|Lyon|Paris", "holiday"}, mediaTagPaths(t, 0))
+
+		_, err = actions.RenameTag(db, findTag(t, "Places|Lyon|Paris").ID, "Lyon")
+		assert.Error(t, err)
+	})
+
+	t.Run("Merge tags", func(t *testing.T) {
+		_, err := actions.AddMediaTag(db, user, []int{media[1].ID}, "France|Paris")
+		assert.NoError(t, err)
+
+		target, err := actions.MergeTags(db, findTag(t, "France").ID, findTag(t, "Places|Lyon").ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Places|Lyon", target.Path)
+
+		assert.Equal(t, []string{"Places|Lyon|Paris", "holiday"}, mediaTagPaths(t, 0))
+		assert.Equal(t, []string{"Places|Lyon|Lyon", "Places|Lyon|Paris"}, mediaTagPaths(t, 1))
+
+		var count int64
+		assert.NoError(t, db.Model(&models.Tag{}).Where("path IN (?)", []string{"France", "France|Paris"}).Count(&count).Error)
+		assert.Equal(t, int64(0), count)
+
+		_, err = actions.MergeTags(db, findTag(t, "Places").ID, findTag(t, "Places|Lyon").ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("Children of tags", func(t *testing.T) {
+		otherAlbum := models.Album{
+			Title: "other",
+			Path:  "/other",
+		}
+		assert.NoError(t, db.Save(&otherAlbum).Error)
+		assert.NoError(t, db.Model(&otherUser).Association("Albums").Append(&otherAlbum))
+
+		otherMedia := models.Media{
+			Title:   "berlin.jpg",
+			Path:    "/other/berlin.jpg",
+			AlbumID: otherAlbum.ID,
+		}
+		assert.NoError(t, db.Save(&otherMedia).Error)
+		assert.NoError(t, models.ReplaceImportedTags(db, otherMedia.ID, [][]string{{"Places", "Germany", "Berlin"}}))
+
+		children, err := actions.TagChildren(db, user, findTag(t, "Places"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Places|Lyon"}, tagPaths(t, children), "tags of media of other users are left out")
+
+		children, err = actions.TagChildren(db, otherUser, findTag(t, "Places"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Places|Germany"}, tagPaths(t, children))
+	})
+}
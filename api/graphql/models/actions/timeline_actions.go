@@ -8,12 +8,13 @@ import (
 	"gorm.io/gorm"
 )
 
-func MyTimeline(db *gorm.DB, user *models.User, paginate *models.Pagination, onlyFavorites *bool, fromDate *time.Time) ([]*models.Media, error) {
+func MyTimeline(db *gorm.DB, user *models.User, paginate *models.Pagination, onlyFavorites *bool, fromDate *time.Time, collapseStacks *bool, allVersions *bool, ratingFilter *models.MediaRatingFilter) ([]*models.Media, error) {
 
 	query := db.
 		Joins("JOIN albums ON media.album_id = albums.id").
 		Where("albums.id IN (?)", db.Table("user_albums").Select("user_albums.album_id").Where("user_id = ?", user.ID))
 
+	// The date of media is the local time of capture, so media are grouped by the day at the place of capture
 	switch drivers.GetDatabaseDriverType(db) {
 	case drivers.POSTGRES:
 		query = query.
@@ -37,13 +38,30 @@ func MyTimeline(db *gorm.DB, user *models.User, paginate *models.Pagination, onl
 	}
 
 	if fromDate != nil {
-		query = query.Where("media.date_shot < ?", fromDate)
+		// Dates of media are stored as the local time of capture, which is what the date of a media returns
+		localFromDate := time.Date(fromDate.Year(), fromDate.Month(), fromDate.Day(),
+			fromDate.Hour(), fromDate.Minute(), fromDate.Second(), fromDate.Nanosecond(), time.UTC)
+		query = query.Where("media.date_shot < ?", localFromDate)
 	}
 
 	if onlyFavorites != nil && *onlyFavorites {
 		query = query.Where("media.id IN (?)", db.Table("user_media_data").Select("user_media_data.media_id").Where("user_media_data.user_id = ?", user.ID).Where("user_media_data.favorite"))
 	}
 
+	if collapseStacks != nil && *collapseStacks {
+		query = query.Where("media.stack_id IS NULL OR media.id IN (?)", db.Model(&models.MediaStack{}).Select("media_stacks.cover_id"))
+	}
+
+	// Only show the newest edited copy of media that has been edited, the others are listed as its versions
+	if allVersions == nil || !*allVersions {
+		query = query.
+			Where("NOT EXISTS (?)", db.Table("media AS edited_media").Where("edited_media.original_media_id = media.id")).
+			Where("media.original_media_id IS NULL OR NOT EXISTS (?)", db.Table("media AS newer_edit").
+				Where("newer_edit.original_media_id = media.original_media_id").
+				Where("newer_edit.id > media.id"))
+	}
+
+	query = models.FilterMediaByRating(query, user.ID, ratingFilter)
 	query = models.FormatSQL(query, nil, paginate)
 
 	var media []*models.Media
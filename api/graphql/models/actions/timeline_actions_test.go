@@ -88,7 +88,7 @@ func TestMyTimeline(t *testing.T) {
 	assert.NoError(t, db.Model(&anotherUser).Association("Albums").Append(&anotherAlbum))
 
 	t.Run("MyTimeline with no filters", func(t *testing.T) {
-		timelineMedia, err := actions.MyTimeline(db, user, nil, nil, nil)
+		timelineMedia, err := actions.MyTimeline(db, user, nil, nil, nil, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Len(t, timelineMedia, 4)
@@ -101,7 +101,7 @@ func TestMyTimeline(t *testing.T) {
 
 	t.Run("MyTimeline with only favorites", func(t *testing.T) {
 		favorites := true
-		timelineMedia, err := actions.MyTimeline(db, user, nil, &favorites, nil)
+		timelineMedia, err := actions.MyTimeline(db, user, nil, &favorites, nil, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Len(t, timelineMedia, 1)
@@ -109,9 +109,72 @@ func TestMyTimeline(t *testing.T) {
 
 	t.Run("MyTimeline before date", func(t *testing.T) {
 		beforeDate := time.Unix(1629792000, 0) // Aug 24 2021 08:00:00
-		timelineMedia, err := actions.MyTimeline(db, user, nil, nil, &beforeDate)
+		timelineMedia, err := actions.MyTimeline(db, user, nil, nil, &beforeDate, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Len(t, timelineMedia, 2)
 	})
+
+	t.Run("MyTimeline with collapsed stacks", func(t *testing.T) {
+		stack := models.MediaStack{
+			AlbumID: rootAlbum.ID,
+			Key:     "burst:test",
+			Kind:    models.MediaStackKindBurst,
+			CoverID: media[0].ID,
+		}
+		assert.NoError(t, db.Save(&stack).Error)
+		assert.NoError(t, db.Model(&models.Media{}).Where("id IN (?)", []int{media[0].ID, media[1].ID}).UpdateColumn("stack_id", stack.ID).Error)
+
+		collapseStacks := true
+		timelineMedia, err := actions.MyTimeline(db, user, nil, nil, nil, &collapseStacks, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, timelineMedia, 3)
+		for _, media := range timelineMedia {
+			assert.NotEqual(t, "pic2", media.Title)
+		}
+	})
+
+	t.Run("MyTimeline with edited versions", func(t *testing.T) {
+		assert.NoError(t, db.Model(&media[3]).UpdateColumn("original_media_id", media[1].ID).Error)
+
+		timelineMedia, err := actions.MyTimeline(db, user, nil, nil, nil, nil, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, timelineMedia, 3)
+		for _, media := range timelineMedia {
+			assert.NotEqual(t, "pic2", media.Title)
+		}
+
+		allVersions := true
+		timelineMedia, err = actions.MyTimeline(db, user, nil, nil, nil, nil, &allVersions, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, timelineMedia, 4)
+	})
+
+	t.Run("MyTimeline with several edited copies", func(t *testing.T) {
+		newerEdit := models.Media{
+			Title:           "pic2 (1)",
+			Path:            "/photos/subalbum/pic2 (1)",
+			AlbumID:         childAlbum.ID,
+			DateShot:        media[1].DateShot,
+			OriginalMediaID: &media[1].ID,
+		}
+		assert.NoError(t, db.Save(&newerEdit).Error)
+
+		timelineMedia, err := actions.MyTimeline(db, user, nil, nil, nil, nil, nil, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, timelineMedia, 3)
+		for _, media := range timelineMedia {
+			assert.NotContains(t, []string{"pic2", "pic4"}, media.Title)
+		}
+
+		allVersions := true
+		timelineMedia, err = actions.MyTimeline(db, user, nil, nil, nil, nil, &allVersions, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, timelineMedia, 5)
+	})
 }
@@ -30,6 +30,43 @@ type MediaDownload struct {
 	MediaURL *MediaURL `json:"mediaUrl"`
 }
 
+// The judgement of a media by a user
+type MediaRating struct {
+	// The number of stars from 0 to 5
+	Stars int       `json:"stars"`
+	Flag  MediaFlag `json:"flag"`
+	// The colour label, such as red or green
+	ColorLabel *string `json:"colorLabel,omitempty"`
+}
+
+// Used to only return media matching the ratings, flags and colour labels of the logged in user
+type MediaRatingFilter struct {
+	// Only media rated with at least this many stars
+	MinStars *int `json:"minStars,omitempty"`
+	// Only media with this flag
+	Flag *MediaFlag `json:"flag,omitempty"`
+	// Only media with this colour label
+	ColorLabel *string `json:"colorLabel,omitempty"`
+}
+
+// One of the original files of a media, such as the RAW file and the JPEG file written alongside it by the camera
+type MediaVersion struct {
+	// The format of the file, such as RAW or JPEG
+	Title string `json:"title"`
+	// Whether the previews of the media are generated from this version
+	Primary  bool      `json:"primary"`
+	MediaURL *MediaURL `json:"mediaUrl"`
+}
+
+// A single metadata tag of a media file
+type MetadataTag struct {
+	// The group of the tag, such as EXIF or XMP
+	Group string `json:"group"`
+	Name  string `json:"name"`
+	// The value of the tag in a human readable format
+	Value string `json:"value"`
+}
+
 type Mutation struct {
 }
 
@@ -66,6 +103,20 @@ type Pagination struct {
 	Offset *int `json:"offset,omitempty"`
 }
 
+// A country, region or city where media were taken, found from the coordinates of the media.
+// The `country`, `region` and `city` fields identify the place in `mediaByPlace`.
+type Place struct {
+	// The name of the country, region or city
+	Name    string  `json:"name"`
+	Country string  `json:"country"`
+	Region  *string `json:"region,omitempty"`
+	City    *string `json:"city,omitempty"`
+	// The number of media owned by the logged in user taken at the place
+	MediaCount int `json:"mediaCount"`
+	// The regions of a country or the cities of a region, the cities of a country are given directly when their region is unknown
+	Children []*Place `json:"children"`
+}
+
 type Query struct {
 }
 
@@ -174,6 +225,93 @@ func (e LanguageTranslation) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Whether a media was picked or rejected while culling
+type MediaFlag string
+
+const (
+	MediaFlagUnflagged MediaFlag = "Unflagged"
+	MediaFlagPick      MediaFlag = "Pick"
+	MediaFlagReject    MediaFlag = "Reject"
+)
+
+var AllMediaFlag = []MediaFlag{
+	MediaFlagUnflagged,
+	MediaFlagPick,
+	MediaFlagReject,
+}
+
+func (e MediaFlag) IsValid() bool {
+	switch e {
+	case MediaFlagUnflagged, MediaFlagPick, MediaFlagReject:
+		return true
+	}
+	return false
+}
+
+func (e MediaFlag) String() string {
+	return string(e)
+}
+
+func (e *MediaFlag) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MediaFlag(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MediaFlag", str)
+	}
+	return nil
+}
+
+func (e MediaFlag) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MediaStackKind string
+
+const (
+	// Frames of a burst sequence
+	MediaStackKindBurst MediaStackKind = "Burst"
+	// Shots of an auto exposure bracket
+	MediaStackKindBracket MediaStackKind = "Bracket"
+)
+
+var AllMediaStackKind = []MediaStackKind{
+	MediaStackKindBurst,
+	MediaStackKindBracket,
+}
+
+func (e MediaStackKind) IsValid() bool {
+	switch e {
+	case MediaStackKindBurst, MediaStackKindBracket:
+		return true
+	}
+	return false
+}
+
+func (e MediaStackKind) String() string {
+	return string(e)
+}
+
+func (e *MediaStackKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MediaStackKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MediaStackKind", str)
+	}
+	return nil
+}
+
+func (e MediaStackKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Specified the type a particular notification is of
 type NotificationType string
 
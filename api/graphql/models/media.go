@@ -14,22 +14,39 @@ import (
 
 type Media struct {
 	Model
-	Title           string         `gorm:"not null"`
-	Path            string         `gorm:"not null"`
-	PathHash        string         `gorm:"not null;unique"`
-	AlbumID         int            `gorm:"not null;index"`
-	Album           Album          `gorm:"constraint:OnDelete:CASCADE;"`
-	ExifID          *int           `gorm:"index"`
-	Exif            *MediaEXIF     `gorm:"constraint:OnDelete:CASCADE;"`
-	MediaURL        []MediaURL     `gorm:"constraint:OnDelete:CASCADE;"`
-	DateShot        time.Time      `gorm:"not null"`
+	Title    string     `gorm:"not null"`
+	Path     string     `gorm:"not null"`
+	PathHash string     `gorm:"not null;unique"`
+	AlbumID  int        `gorm:"not null;index"`
+	Album    Album      `gorm:"constraint:OnDelete:CASCADE;"`
+	ExifID   *int       `gorm:"index"`
+	Exif     *MediaEXIF `gorm:"constraint:OnDelete:CASCADE;"`
+	MediaURL []MediaURL `gorm:"constraint:OnDelete:CASCADE;"`
+	DateShot time.Time  `gorm:"not null"`
+	// DateShotOffset is the offset in seconds from UTC of DateShot, nil if it is unknown
+	DateShotOffset  *int
 	Type            MediaType      `gorm:"not null;index"`
 	VideoMetadataID *int           `gorm:"index"`
 	VideoMetadata   *VideoMetadata `gorm:"constraint:OnDelete:CASCADE;"`
+	PanoramaID      *int           `gorm:"index"`
+	Panorama        *MediaPanorama `gorm:"constraint:OnDelete:CASCADE;"`
+	StackID         *int           `gorm:"index"`
+	Stack           *MediaStack    `gorm:"constraint:OnDelete:SET NULL;"`
 	SideCarPath     *string
-	SideCarHash     *string      `gorm:"unique"`
+	SideCarHash     *string      `gorm:"index"`
 	Faces           []*ImageFace `gorm:"constraint:OnDelete:CASCADE;"`
 	Blurhash        *string      `gorm:""`
+	// PosterTimestamp overrides the automatically chosen poster frame of a video, in seconds
+	PosterTimestamp *float64
+	// CounterpartPath is the JPEG file written by the camera alongside a RAW file, kept as another version of the media
+	CounterpartPath *string
+	// OriginalMediaID links an edited copy to the media it was made from, both being versions of the same photo
+	OriginalMediaID *int   `gorm:"index"`
+	OriginalMedia   *Media `gorm:"constraint:OnDelete:SET NULL;"`
+	// DocumentID and DerivedFromDocumentID are the XMP ids used to find the original of an edited copy
+	DocumentID            *string
+	DerivedFromDocumentID *string
+	Tags                  []*Tag `gorm:"many2many:media_tags;constraint:OnDelete:CASCADE;"`
 }
 
 func (Media) TableName() string {
@@ -43,8 +60,19 @@ func (m *Media) BeforeSave(tx *gorm.DB) error {
 	return nil
 }
 
+// Date returns the local time at the place of capture, in the timezone of the capture if its offset is known
 func (m *Media) Date() time.Time {
-	return m.DateShot
+	if m.DateShotOffset == nil {
+		return m.DateShot
+	}
+
+	return LocalDate(m.DateShot, *m.DateShotOffset)
+}
+
+// LocalDate returns the wall clock time of the date, as stored for media, in the timezone with the given offset in seconds
+func LocalDate(date time.Time, offset int) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), date.Hour(), date.Minute(), date.Second(), date.Nanosecond(),
+		time.FixedZone("", offset))
 }
 
 func (m *Media) GetThumbnail() (*MediaURL, error) {
@@ -101,8 +129,16 @@ const (
 	MediaOriginal  MediaPurpose = "original"
 	VideoWeb       MediaPurpose = "video-web"
 	VideoThumbnail MediaPurpose = "video-thumbnail"
+	MotionVideo    MediaPurpose = "motion-video"
+	VideoHLS       MediaPurpose = "video-hls"
+	VideoPreview   MediaPurpose = "video-preview"
+	// MediaCounterpart is the JPEG version of a RAW original, served from the `Media.CounterpartPath`
+	MediaCounterpart MediaPurpose = "counterpart"
 )
 
+// HLSMasterPlaylist is the name of the master playlist inside the cache directory of a `VideoHLS` media url
+const HLSMasterPlaylist = "master.m3u8"
+
 type MediaURL struct {
 	Model
 	MediaID     int          `gorm:"not null;index"`
@@ -113,15 +149,21 @@ type MediaURL struct {
 	Purpose     MediaPurpose `gorm:"not null;index"`
 	ContentType string       `gorm:"not null"`
 	FileSize    int64        `gorm:"not null"`
+	// EncodingProfile and EncodingParameters describe how a transcoded video was encoded
+	EncodingProfile    *string
+	EncodingParameters *string
 }
 
 func (p *MediaURL) URL() string {
 
 	imageURL := utils.ApiEndpointUrl()
-	if p.Purpose != VideoWeb {
-		imageURL.Path = path.Join(imageURL.Path, "photo", p.MediaName)
-	} else {
+	switch p.Purpose {
+	case VideoWeb, MotionVideo, VideoPreview:
 		imageURL.Path = path.Join(imageURL.Path, "video", p.MediaName)
+	case VideoHLS:
+		imageURL.Path = path.Join(imageURL.Path, "hls", p.MediaName, HLSMasterPlaylist)
+	default:
+		imageURL.Path = path.Join(imageURL.Path, "photo", p.MediaName)
 	}
 
 	return imageURL.String()
@@ -134,10 +176,15 @@ func (p *MediaURL) CachedPath() (string, error) {
 		return "", errors.New("mediaURL.Media is nil")
 	}
 
-	if p.Purpose == PhotoThumbnail || p.Purpose == PhotoHighRes || p.Purpose == VideoThumbnail || p.Purpose == VideoWeb {
+	if p.Purpose == PhotoThumbnail || p.Purpose == PhotoHighRes || p.Purpose == VideoThumbnail || p.Purpose == VideoWeb || p.Purpose == MotionVideo || p.Purpose == VideoHLS || p.Purpose == VideoPreview {
 		cachedPath = path.Join(utils.MediaCachePath(), strconv.Itoa(int(p.Media.AlbumID)), strconv.Itoa(int(p.MediaID)), p.MediaName)
 	} else if p.Purpose == MediaOriginal {
 		cachedPath = p.Media.Path
+	} else if p.Purpose == MediaCounterpart {
+		if p.Media.CounterpartPath == nil {
+			return "", errors.New("media.CounterpartPath is nil")
+		}
+		cachedPath = *p.Media.CounterpartPath
 	} else {
 		return "", errors.New(fmt.Sprintf("cannot determine cache path for purpose (%s)", p.Purpose))
 	}
@@ -6,11 +6,15 @@ import (
 
 type MediaEXIF struct {
 	Model
-	Description     *string
-	Camera          *string
-	Maker           *string
-	Lens            *string
-	DateShot        *time.Time
+	Title       *string
+	Description *string
+	Camera      *string
+	Maker       *string
+	Lens        *string
+	DateShot    *time.Time
+	// DateShotOffset is the offset in seconds from UTC of DateShot, which is the local time at the place of capture.
+	// It is nil if the offset is unknown.
+	DateShotOffset  *int
 	Exposure        *float64
 	Aperture        *float64
 	Iso             *int64
@@ -20,6 +24,21 @@ type MediaEXIF struct {
 	ExposureProgram *int64
 	GPSLatitude     *float64
 	GPSLongitude    *float64
+	// Country, Region and City are found from the coordinates
+	Country *string
+	Region  *string
+	City    *string
+	// BurstID is shared by all frames of a burst sequence
+	BurstID *string
+	// BracketValue is the exposure compensation of the shot within an auto exposure bracket
+	BracketValue   *float64
+	SequenceNumber *int64
+	// Rating is the number of stars from 0 to 5, or -1 for rejected media
+	Rating     *int64
+	ColorLabel *string
+	Keywords   StringList
+	// HierarchicalKeywords are the keywords with their parents, separated by `|`
+	HierarchicalKeywords StringList
 }
 
 func (MediaEXIF) TableName() string {
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MediaOverride holds the capture date, location, title and description of a media set by a user.
+// They are kept apart from the metadata, so importing the metadata again does not overwrite them.
+type MediaOverride struct {
+	Model
+	MediaID int    `gorm:"not null;unique"`
+	Media   *Media `gorm:"constraint:OnDelete:CASCADE;"`
+	// DateShot is the local time at the place of capture, with its offset in seconds from UTC
+	DateShot       *time.Time
+	DateShotOffset *int
+	GPSLatitude    *float64
+	GPSLongitude   *float64
+	// LocationFromTrack is set when the location was interpolated from a GPX track instead of set by the user,
+	// so it can be replaced when a track is imported again or when a location is added to the file
+	LocationFromTrack bool `gorm:"not null;default:false"`
+	// Title and Description are empty strings when the user removed the value found in the metadata
+	Title       *string
+	Description *string
+}
+
+// The columns holding each of the overridden values
+var (
+	MediaOverrideDateColumns        = []string{"date_shot", "date_shot_offset"}
+	MediaOverrideLocationColumns    = []string{"gps_latitude", "gps_longitude", "location_from_track"}
+	MediaOverrideTitleColumns       = []string{"title"}
+	MediaOverrideDescriptionColumns = []string{"description"}
+)
+
+// FindMediaOverride returns the overrides of the media, or nil if it has none
+func FindMediaOverride(tx *gorm.DB, mediaID int) (*MediaOverride, error) {
+	var override MediaOverride
+	result := tx.Where("media_id = ?", mediaID).Limit(1).Find(&override)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "get media overrides from database")
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return &override, nil
+}
+
+// IsEmpty tells if none of the values are overridden anymore
+func (override *MediaOverride) IsEmpty() bool {
+	return override.DateShot == nil && override.GPSLatitude == nil && override.Title == nil && override.Description == nil
+}
+
+// ApplyTo replaces the date, location, title and description of the metadata with the overridden values
+func (override *MediaOverride) ApplyTo(exif *MediaEXIF) {
+	if override.DateShot != nil {
+		exif.DateShot = override.DateShot
+		exif.DateShotOffset = override.DateShotOffset
+	}
+
+	if override.GPSLatitude != nil && override.GPSLongitude != nil {
+		exif.GPSLatitude = override.GPSLatitude
+		exif.GPSLongitude = override.GPSLongitude
+	}
+
+	if override.Title != nil {
+		exif.Title = emptyStringToNil(override.Title)
+	}
+
+	if override.Description != nil {
+		exif.Description = emptyStringToNil(override.Description)
+	}
+}
+
+func emptyStringToNil(value *string) *string {
+	if *value == "" {
+		return nil
+	}
+	return value
+}
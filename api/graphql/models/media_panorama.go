@@ -0,0 +1,20 @@
+package models
+
+// MediaPanorama describes how a 360° photo or video is projected, following the GPano XMP namespace.
+// The cropped area fields describe the part of the full panorama covered by the image, in pixels.
+type MediaPanorama struct {
+	Model
+	ProjectionType         string `gorm:"not null"`
+	FullPanoWidth          *int
+	FullPanoHeight         *int
+	CroppedAreaLeft        *int
+	CroppedAreaTop         *int
+	CroppedAreaImageWidth  *int
+	CroppedAreaImageHeight *int
+}
+
+const PanoramaEquirectangular = "equirectangular"
+
+func (panorama *MediaPanorama) IsEquirectangular() bool {
+	return panorama.ProjectionType == PanoramaEquirectangular
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// SQL expressions of the rating of a media by a user, falling back to the metadata of the media for values the user has not set.
+// A rating of -1 in the metadata marks the media as rejected.
+const (
+	mediaStarsSQL = "COALESCE(" +
+		"(SELECT user_media_data.rating FROM user_media_data WHERE user_media_data.media_id = media.id AND user_media_data.user_id = ?), " +
+		"(SELECT media_exif.rating FROM media_exif WHERE media_exif.id = media.exif_id AND media_exif.rating >= 0), 0)"
+	mediaFlagSQL = "COALESCE(" +
+		"(SELECT user_media_data.flag FROM user_media_data WHERE user_media_data.media_id = media.id AND user_media_data.user_id = ?), " +
+		"(SELECT '" + string(MediaFlagReject) + "' FROM media_exif WHERE media_exif.id = media.exif_id AND media_exif.rating = -1), " +
+		"'" + string(MediaFlagUnflagged) + "')"
+	mediaColorLabelSQL = "COALESCE(" +
+		"(SELECT user_media_data.color_label FROM user_media_data WHERE user_media_data.media_id = media.id AND user_media_data.user_id = ?), " +
+		"(SELECT media_exif.color_label FROM media_exif WHERE media_exif.id = media.exif_id), '')"
+)
+
+// FilterMediaByRating limits a query of media to the media matching the rating filter, as rated by the given user
+func FilterMediaByRating(query *gorm.DB, userID int, filter *MediaRatingFilter) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+
+	if filter.MinStars != nil {
+		query = query.Where(mediaStarsSQL+" >= ?", userID, *filter.MinStars)
+	}
+
+	if filter.Flag != nil {
+		query = query.Where(mediaFlagSQL+" = ?", userID, *filter.Flag)
+	}
+
+	if filter.ColorLabel != nil {
+		query = query.Where("LOWER("+mediaColorLabelSQL+") = LOWER(?)", userID, *filter.ColorLabel)
+	}
+
+	return query
+}
+
+// RatingForUser returns the rating of the media by the user, or by anonymous users if the user is nil.
+// Values not set by the user are taken from the metadata of the media.
+func (media *Media) RatingForUser(db *gorm.DB, user *User) (*MediaRating, error) {
+	rating := MediaRating{
+		Stars: 0,
+		Flag:  MediaFlagUnflagged,
+	}
+
+	mediaExif := media.Exif
+	if mediaExif == nil && media.ExifID != nil {
+		mediaExif = &MediaEXIF{}
+		if err := db.Select("id", "rating", "color_label").First(mediaExif, *media.ExifID).Error; err != nil {
+			return nil, errors.Wrap(err, "get media metadata for rating")
+		}
+	}
+
+	if mediaExif != nil {
+		if mediaExif.Rating != nil {
+			if *mediaExif.Rating < 0 {
+				rating.Flag = MediaFlagReject
+			} else {
+				rating.Stars = int(*mediaExif.Rating)
+			}
+		}
+		rating.ColorLabel = mediaExif.ColorLabel
+	}
+
+	if user == nil {
+		return &rating, nil
+	}
+
+	var userMediaData UserMediaData
+	result := db.Where("user_id = ? AND media_id = ?", user.ID, media.ID).Limit(1).Find(&userMediaData)
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "get user rating of media")
+	}
+
+	if result.RowsAffected == 0 {
+		return &rating, nil
+	}
+
+	if userMediaData.Rating != nil {
+		rating.Stars = *userMediaData.Rating
+	}
+	if userMediaData.Flag != nil {
+		rating.Flag = *userMediaData.Flag
+	}
+	if userMediaData.ColorLabel != nil {
+		// The label is stored as an empty string when the user removed it
+		rating.ColorLabel = userMediaData.ColorLabel
+		if *userMediaData.ColorLabel == "" {
+			rating.ColorLabel = nil
+		}
+	}
+
+	return &rating, nil
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+
+	"github.com/photoview/photoview/api/database/drivers"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// MediaRawMetadata holds every metadata tag read from a media file, not only the ones stored in MediaEXIF
+type MediaRawMetadata struct {
+	Model
+	MediaID int    `gorm:"not null;unique"`
+	Media   *Media `gorm:"constraint:OnDelete:CASCADE;"`
+	Tags    RawMetadata
+}
+
+func (MediaRawMetadata) TableName() string {
+	return "media_raw_metadata"
+}
+
+// RawMetadata maps the names of metadata groups, such as EXIF or XMP, to the tags of the group and their values.
+// It is stored as a JSON object in a single column.
+type RawMetadata map[string]map[string]string
+
+func (metadata RawMetadata) Value() (driver.Value, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(map[string]map[string]string(metadata))
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (metadata *RawMetadata) Scan(value interface{}) error {
+	var data []byte
+	switch value := value.(type) {
+	case nil:
+		*metadata = nil
+		return nil
+	case string:
+		data = []byte(value)
+	case []byte:
+		data = value
+	default:
+		return errors.Errorf("unsupported type for raw metadata: %T", value)
+	}
+
+	return json.Unmarshal(data, (*map[string]map[string]string)(metadata))
+}
+
+func (RawMetadata) GormDataType() string {
+	return "text"
+}
+
+// GormDBDataType uses LONGTEXT on MySQL, as a full metadata dump can be larger than the 64 KB of a TEXT column
+func (RawMetadata) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch drivers.GetDatabaseDriverType(db) {
+	case drivers.MYSQL:
+		return "LONGTEXT"
+	}
+	return "TEXT"
+}
+
+// Set stores the value of a tag in the group
+func (metadata RawMetadata) Set(group string, name string, value string) {
+	if metadata[group] == nil {
+		metadata[group] = make(map[string]string)
+	}
+	metadata[group][name] = value
+}
+
+// List returns the tags of the given groups sorted by group and name, or the tags of all groups if none are given
+func (metadata RawMetadata) List(groups []string) []*MetadataTag {
+	includeGroup := make(map[string]bool)
+	for _, group := range groups {
+		includeGroup[group] = true
+	}
+
+	tags := make([]*MetadataTag, 0)
+	for group, values := range metadata {
+		if len(groups) > 0 && !includeGroup[group] {
+			continue
+		}
+
+		for name, value := range values {
+			tags = append(tags, &MetadataTag{
+				Group: group,
+				Name:  name,
+				Value: value,
+			})
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Group != tags[j].Group {
+			return tags[i].Group < tags[j].Group
+		}
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags
+}
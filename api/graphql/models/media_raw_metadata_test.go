@@ -0,0 +1,40 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawMetadata(t *testing.T) {
+	metadata := make(models.RawMetadata)
+	metadata.Set("XMP", "Rating", "3")
+	metadata.Set("EXIF", "Model", "Canon EOS 600D")
+	metadata.Set("EXIF", "MeteringMode", "Evaluative")
+
+	t.Run("List all groups", func(t *testing.T) {
+		assert.Equal(t, []*models.MetadataTag{
+			{Group: "EXIF", Name: "MeteringMode", Value: "Evaluative"},
+			{Group: "EXIF", Name: "Model", Value: "Canon EOS 600D"},
+			{Group: "XMP", Name: "Rating", Value: "3"},
+		}, metadata.List(nil))
+	})
+
+	t.Run("List some groups", func(t *testing.T) {
+		assert.Equal(t, []*models.MetadataTag{
+			{Group: "XMP", Name: "Rating", Value: "3"},
+		}, metadata.List([]string{"XMP", "IPTC"}))
+
+		assert.Empty(t, models.RawMetadata(nil).List(nil))
+	})
+
+	t.Run("Store as JSON", func(t *testing.T) {
+		value, err := metadata.Value()
+		assert.NoError(t, err)
+
+		var scanned models.RawMetadata
+		assert.NoError(t, scanned.Scan(value))
+		assert.Equal(t, metadata, scanned)
+	})
+}
@@ -0,0 +1,13 @@
+package models
+
+// MediaStack groups near-identical photos of an album, such as the frames of a burst sequence
+// or the shots of an exposure bracket, so they can be shown as a single item represented by the cover.
+type MediaStack struct {
+	Model
+	AlbumID int   `gorm:"not null;index"`
+	Album   Album `gorm:"constraint:OnDelete:CASCADE;"`
+	// Key identifies the stack between scans, it is derived from the burst id or the first media of the stack
+	Key     string         `gorm:"not null"`
+	Kind    MediaStackKind `gorm:"not null"`
+	CoverID int            `gorm:"not null"`
+}
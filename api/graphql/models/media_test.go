@@ -48,6 +48,15 @@ func TestMediaURLCachePath(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "media_cache/2/1/media_thumb.jpg", path)
 
+	counterpartPath := "/photos/media.jpg"
+	mediaUrl.Purpose = models.MediaCounterpart
+	mediaUrl.Media.Path = "/photos/media.cr2"
+	mediaUrl.Media.CounterpartPath = &counterpartPath
+
+	path, err = mediaUrl.CachedPath()
+
+	assert.NoError(t, err)
+	assert.Equal(t, counterpartPath, path)
 }
 
 func TestMediaURLGetURL(t *testing.T) {
@@ -66,6 +75,14 @@ func TestMediaURLGetURL(t *testing.T) {
 	}
 
 	assert.Equal(t, "video/video.mp4", video.URL())
+
+	hls := models.MediaURL{
+		MediaName:   "hls_video_mp4",
+		ContentType: "application/vnd.apple.mpegurl",
+		Purpose:     models.VideoHLS,
+	}
+
+	assert.Equal(t, "hls/hls_video_mp4/master.m3u8", hls.URL())
 }
 
 func TestMediaGetThumbnail(t *testing.T) {
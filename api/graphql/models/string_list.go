@@ -0,0 +1,57 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/photoview/photoview/api/database/drivers"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// StringList is a list of strings stored as a JSON array in a single column
+type StringList []string
+
+func (list StringList) Value() (driver.Value, error) {
+	if list == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal([]string(list))
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (list *StringList) Scan(value interface{}) error {
+	var data []byte
+	switch value := value.(type) {
+	case nil:
+		*list = nil
+		return nil
+	case string:
+		data = []byte(value)
+	case []byte:
+		data = value
+	default:
+		return errors.Errorf("unsupported type for string list: %T", value)
+	}
+
+	return json.Unmarshal(data, (*[]string)(list))
+}
+
+func (StringList) GormDataType() string {
+	return "text"
+}
+
+// GormDBDataType uses LONGTEXT on MySQL, as long lists can be larger than the 64 KB of a TEXT column
+func (StringList) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	switch drivers.GetDatabaseDriverType(db) {
+	case drivers.MYSQL:
+		return "LONGTEXT"
+	}
+	return "TEXT"
+}
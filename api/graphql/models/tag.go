@@ -0,0 +1,242 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagPathSeparator separates the names of nested tags in a tag path, as in the `lr:hierarchicalSubject` XMP property
+const TagPathSeparator = "|"
+
+// Tag is a keyword attached to media, which can be nested below a parent tag
+type Tag struct {
+	Model
+	Name string `gorm:"not null"`
+	// Path is the names of the parents of the tag and the tag itself, separated by TagPathSeparator
+	Path     string `gorm:"not null"`
+	PathHash string `gorm:"not null;unique"`
+	ParentID *int   `gorm:"index"`
+	Parent   *Tag   `gorm:"constraint:OnDelete:CASCADE;"`
+}
+
+// MediaTag links a tag to a media
+type MediaTag struct {
+	MediaID int `gorm:"primaryKey;autoIncrement:false;constraint:OnDelete:CASCADE;"`
+	TagID   int `gorm:"primaryKey;autoIncrement:false;constraint:OnDelete:CASCADE;"`
+	// Imported is set for tags read from the metadata of the media, which are replaced when the metadata is imported again
+	Imported bool `gorm:"not null;default:false"`
+}
+
+func (t *Tag) BeforeSave(tx *gorm.DB) error {
+	t.PathHash = MD5Hash(t.Path)
+	return nil
+}
+
+// SplitTagPath returns the names of the tags in a tag path, without empty names and surrounding whitespace
+func SplitTagPath(path string) []string {
+	names := make([]string, 0)
+	for _, name := range strings.Split(path, TagPathSeparator) {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// FindOrCreateTag returns the tag with the given names of its parents and itself, creating the tags that do not exist
+func FindOrCreateTag(tx *gorm.DB, names []string) (*Tag, error) {
+	if len(names) == 0 {
+		return nil, errors.New("a tag must have a name")
+	}
+
+	var parent *Tag
+	for i, name := range names {
+		if strings.Contains(name, TagPathSeparator) {
+			return nil, errors.Errorf("tag names can not contain %s", TagPathSeparator)
+		}
+
+		path := strings.Join(names[:i+1], TagPathSeparator)
+
+		var tag Tag
+		result := tx.Where("path_hash = ?", MD5Hash(path)).Limit(1).Find(&tag)
+		if result.Error != nil {
+			return nil, errors.Wrapf(result.Error, "find tag (%s)", path)
+		}
+
+		if result.RowsAffected == 0 {
+			tag = Tag{
+				Name: name,
+				Path: path,
+			}
+			if parent != nil {
+				tag.ParentID = &parent.ID
+			}
+
+			if err := tx.Create(&tag).Error; err != nil {
+				return nil, errors.Wrapf(err, "create tag (%s)", path)
+			}
+		}
+
+		parent = &tag
+	}
+
+	return parent, nil
+}
+
+// ReplaceImportedTags replaces the tags of the media that were imported from its metadata.
+// Tags added to the media by users are kept.
+func ReplaceImportedTags(tx *gorm.DB, mediaID int, tagPaths [][]string) error {
+	if err := tx.Where("media_id = ? AND imported = ?", mediaID, true).Delete(&MediaTag{}).Error; err != nil {
+		return errors.Wrap(err, "remove previously imported tags")
+	}
+
+	for _, names := range tagPaths {
+		tag, err := FindOrCreateTag(tx, names)
+		if err != nil {
+			return err
+		}
+
+		mediaTag := MediaTag{
+			MediaID:  mediaID,
+			TagID:    tag.ID,
+			Imported: true,
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&mediaTag).Error; err != nil {
+			return errors.Wrapf(err, "add imported tag (%s)", tag.Path)
+		}
+	}
+
+	return nil
+}
+
+// Descendants returns the tags nested below the tag, at any depth
+func (t *Tag) Descendants(tx *gorm.DB) ([]*Tag, error) {
+	prefix := t.Path + TagPathSeparator
+
+	var candidates []*Tag
+	if err := tx.Where("path LIKE ?", prefix+"%").Find(&candidates).Error; err != nil {
+		return nil, errors.Wrapf(err, "get child tags of %s", t.Path)
+	}
+
+	// LIKE treats % and _ in the path as wildcards, so the prefix is checked again
+	descendants := make([]*Tag, 0, len(candidates))
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate.Path, prefix) {
+			descendants = append(descendants, candidate)
+		}
+	}
+
+	return descendants, nil
+}
+
+// Rename changes the name of the tag, updating the paths of the tags nested below it.
+// An error is returned if the parent of the tag already has a child with the new name.
+func (t *Tag) Rename(tx *gorm.DB, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("a tag must have a name")
+	}
+	if strings.Contains(name, TagPathSeparator) {
+		return errors.Errorf("tag names can not contain %s", TagPathSeparator)
+	}
+
+	newPath := name
+	if index := strings.LastIndex(t.Path, TagPathSeparator); index != -1 {
+		newPath = t.Path[:index+len(TagPathSeparator)] + name
+	}
+
+	if newPath != t.Path {
+		var count int64
+		if err := tx.Model(&Tag{}).Where("path_hash = ?", MD5Hash(newPath)).Count(&count).Error; err != nil {
+			return errors.Wrap(err, "check for existing tag")
+		}
+		if count > 0 {
+			return errors.Errorf("a tag named %s already exists, merge the tags instead", newPath)
+		}
+	}
+
+	t.Name = name
+	return t.moveTo(tx, newPath)
+}
+
+// MergeInto moves the media and the nested tags of the tag to the target tag, and deletes the tag afterwards.
+// Nested tags with the same name as a nested tag of the target are merged as well.
+func (t *Tag) MergeInto(tx *gorm.DB, target *Tag) error {
+	if t.ID == target.ID {
+		return errors.New("a tag can not be merged with itself")
+	}
+	if strings.HasPrefix(target.Path+TagPathSeparator, t.Path+TagPathSeparator) {
+		return errors.New("a tag can not be merged into a tag nested below it")
+	}
+
+	err := tx.Exec("INSERT INTO media_tags (media_id, tag_id, imported) "+
+		"SELECT media_id, ?, imported FROM media_tags WHERE tag_id = ? "+
+		"AND media_id NOT IN (SELECT media_id FROM media_tags WHERE tag_id = ?)",
+		target.ID, t.ID, target.ID).Error
+	if err != nil {
+		return errors.Wrapf(err, "move media of tag %s to %s", t.Path, target.Path)
+	}
+
+	var children []*Tag
+	if err := tx.Where("parent_id = ?", t.ID).Find(&children).Error; err != nil {
+		return errors.Wrapf(err, "get child tags of %s", t.Path)
+	}
+
+	for _, child := range children {
+		childPath := target.Path + TagPathSeparator + child.Name
+
+		var existing Tag
+		result := tx.Where("path_hash = ?", MD5Hash(childPath)).Limit(1).Find(&existing)
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "find tag (%s)", childPath)
+		}
+
+		if result.RowsAffected > 0 {
+			if err := child.MergeInto(tx, &existing); err != nil {
+				return err
+			}
+			continue
+		}
+
+		child.ParentID = &target.ID
+		if err := child.moveTo(tx, childPath); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Where("tag_id = ?", t.ID).Delete(&MediaTag{}).Error; err != nil {
+		return errors.Wrapf(err, "remove media from merged tag %s", t.Path)
+	}
+
+	if err := tx.Delete(t).Error; err != nil {
+		return errors.Wrapf(err, "delete merged tag %s", t.Path)
+	}
+
+	return nil
+}
+
+// moveTo saves the tag with a new path, and updates the paths of the tags nested below it
+func (t *Tag) moveTo(tx *gorm.DB, newPath string) error {
+	descendants, err := t.Descendants(tx)
+	if err != nil {
+		return err
+	}
+
+	oldPath := t.Path
+	t.Path = newPath
+	if err := tx.Save(t).Error; err != nil {
+		return errors.Wrapf(err, "update tag %s", oldPath)
+	}
+
+	for _, descendant := range descendants {
+		descendant.Path = newPath + strings.TrimPrefix(descendant.Path, oldPath)
+		if err := tx.Save(descendant).Error; err != nil {
+			return errors.Wrapf(err, "update path of nested tag %s", descendant.Path)
+		}
+	}
+
+	return nil
+}
@@ -25,6 +25,10 @@ type UserMediaData struct {
 	UserID   int  `gorm:"primaryKey;autoIncrement:false"`
 	MediaID  int  `gorm:"primaryKey;autoIncrement:false"`
 	Favorite bool `gorm:"not null;default:false"`
+	// Rating, Flag and ColorLabel are nil until set by the user, the metadata of the media is used until then
+	Rating     *int
+	Flag       *MediaFlag
+	ColorLabel *string
 }
 
 type UserAlbums struct {
@@ -185,7 +189,11 @@ func (user *User) FavoriteMedia(db *gorm.DB, mediaID int, favorite bool) (*Media
 		Favorite: favorite,
 	}
 
-	if err := db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&userMediaData).Error; err != nil {
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "media_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"favorite", "updated_at"}),
+	}).Create(&userMediaData).Error
+	if err != nil {
 		return nil, errors.Wrapf(err, "update user favorite media in database")
 	}
 
@@ -10,8 +10,62 @@ type VideoMetadata struct {
 	Bitrate      *string
 	ColorProfile *string
 	Audio        *string
+	// Rotation is the clockwise rotation in degrees needed to display the video upright
+	Rotation      int `gorm:"not null;default:0"`
+	DisplayMatrix *string
+	// DisplayWidth and DisplayHeight are the dimensions of the video after rotation and pixel aspect ratio correction
+	DisplayWidth    *int
+	DisplayHeight   *int
+	ColorTransfer   *string
+	ColorPrimaries  *string
+	ContainerFormat *string
+	Streams         []VideoStream  `gorm:"constraint:OnDelete:CASCADE;"`
+	Chapters        []VideoChapter `gorm:"constraint:OnDelete:CASCADE;"`
 }
 
 func (metadata *VideoMetadata) Media() *Media {
 	panic("not implemented")
 }
+
+// HDR returns true if the video uses a high dynamic range transfer function, either PQ (HDR10, Dolby Vision) or HLG
+func (metadata *VideoMetadata) HDR() bool {
+	if metadata.ColorTransfer == nil {
+		return false
+	}
+
+	switch *metadata.ColorTransfer {
+	case "smpte2084", "arib-std-b67":
+		return true
+	}
+
+	return false
+}
+
+type VideoStreamType string
+
+const (
+	VideoStreamAudio    VideoStreamType = "audio"
+	VideoStreamSubtitle VideoStreamType = "subtitle"
+)
+
+// VideoStream is an audio or subtitle stream of a video
+type VideoStream struct {
+	Model
+	VideoMetadataID int             `gorm:"not null;index"`
+	Index           int             `gorm:"column:stream_index;not null"`
+	Type            VideoStreamType `gorm:"not null"`
+	Codec           *string
+	Language        *string
+	Title           *string
+	Channels        *int
+	Default         bool `gorm:"not null;default:false"`
+}
+
+// VideoChapter is a chapter marker of a video, with start and end times in seconds
+type VideoChapter struct {
+	Model
+	VideoMetadataID int     `gorm:"not null;index"`
+	StartTime       float64 `gorm:"not null"`
+	EndTime         float64 `gorm:"not null"`
+	Title           *string
+}
@@ -59,7 +59,7 @@ func (r *Resolver) Album() api.AlbumResolver {
 
 type albumResolver struct{ *Resolver }
 
-func (r *albumResolver) Media(ctx context.Context, album *models.Album, order *models.Ordering, paginate *models.Pagination, onlyFavorites *bool) ([]*models.Media, error) {
+func (r *albumResolver) Media(ctx context.Context, album *models.Album, order *models.Ordering, paginate *models.Pagination, onlyFavorites *bool, collapseStacks *bool, ratingFilter *models.MediaRatingFilter) ([]*models.Media, error) {
 	db := r.DB(ctx)
 
 	query := db.
@@ -79,6 +79,19 @@ func (r *albumResolver) Media(ctx context.Context, album *models.Album, order *m
 		query = query.Where("EXISTS (?)", favoriteQuery)
 	}
 
+	if collapseStacks != nil && *collapseStacks {
+		query = query.Where("media.stack_id IS NULL OR media.id IN (?)", db.Model(&models.MediaStack{}).Select("media_stacks.cover_id"))
+	}
+
+	if ratingFilter != nil {
+		// Media shared without being logged in is filtered by the ratings from its metadata
+		userID := 0
+		if user := auth.UserFromContext(ctx); user != nil {
+			userID = user.ID
+		}
+		query = models.FilterMediaByRating(query, userID, ratingFilter)
+	}
+
 	query = models.FormatSQL(query, order, paginate)
 
 	var media []*models.Media
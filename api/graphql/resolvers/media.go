@@ -2,24 +2,30 @@ package resolvers
 
 import (
 	"context"
+	"path"
 	"strings"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/photoview/photoview/api/dataloader"
 	api "github.com/photoview/photoview/api/graphql"
 	"github.com/photoview/photoview/api/graphql/auth"
 	"github.com/photoview/photoview/api/graphql/models"
 	"github.com/photoview/photoview/api/graphql/models/actions"
 	"github.com/photoview/photoview/api/scanner/face_detection"
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/photoview/photoview/api/scanner/media_type"
+	"github.com/photoview/photoview/api/scanner/scanner_queue"
 	"github.com/pkg/errors"
 )
 
-func (r *queryResolver) MyMedia(ctx context.Context, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+func (r *queryResolver) MyMedia(ctx context.Context, order *models.Ordering, paginate *models.Pagination, ratingFilter *models.MediaRatingFilter) ([]*models.Media, error) {
 	user := auth.UserFromContext(ctx)
 	if user == nil {
 		return nil, errors.New("unauthorized")
 	}
 
-	return actions.MyMedia(r.DB(ctx), user, order, paginate)
+	return actions.MyMedia(r.DB(ctx), user, order, paginate, ratingFilter)
 }
 
 func (r *queryResolver) Media(ctx context.Context, id int, tokenCredentials *models.ShareTokenCredentials) (*models.Media, error) {
@@ -124,10 +130,17 @@ func (r *mediaResolver) Downloads(ctx context.Context, media *models.Media) ([]*
 
 	for _, url := range mediaUrls {
 
+		// The HLS stream is a directory of segments, that can not be downloaded as a single file
+		if url.Purpose == models.VideoHLS {
+			continue
+		}
+
 		var title string
 		switch {
 		case url.Purpose == models.MediaOriginal:
 			title = "Original"
+		case url.Purpose == models.MediaCounterpart:
+			title = "Original JPEG"
 		case url.Purpose == models.PhotoThumbnail:
 			title = "Small"
 		case url.Purpose == models.PhotoHighRes:
@@ -136,6 +149,10 @@ func (r *mediaResolver) Downloads(ctx context.Context, media *models.Media) ([]*
 			title = "Video thumbnail"
 		case url.Purpose == models.VideoWeb:
 			title = "Web optimized video"
+		case url.Purpose == models.MotionVideo:
+			title = "Motion video"
+		case url.Purpose == models.VideoPreview:
+			title = "Video preview"
 		}
 
 		downloads = append(downloads, &models.MediaDownload{
@@ -147,6 +164,46 @@ func (r *mediaResolver) Downloads(ctx context.Context, media *models.Media) ([]*
 	return downloads, nil
 }
 
+func (r *mediaResolver) Versions(ctx context.Context, media *models.Media) ([]*models.MediaVersion, error) {
+	var mediaUrls []*models.MediaURL
+	err := r.DB(ctx).
+		Where("media_id = ?", media.ID).
+		Where("purpose IN (?)", []models.MediaPurpose{models.MediaOriginal, models.MediaCounterpart}).
+		Order("id").
+		Find(&mediaUrls).Error
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "get versions for media (%s)", media.Path)
+	}
+
+	// Previews of a RAW photo are generated from its JPEG version, unless configured otherwise and a RAW converter is installed
+	counterpartIsPrimary := media.CounterpartPath != nil && !executable_worker.RawVersionIsPrimary()
+
+	versions := make([]*models.MediaVersion, 0, len(mediaUrls))
+	for _, url := range mediaUrls {
+		versionPath := media.Path
+		if url.Purpose == models.MediaCounterpart {
+			if media.CounterpartPath == nil {
+				continue
+			}
+			versionPath = *media.CounterpartPath
+		}
+
+		title := strings.ToUpper(strings.TrimPrefix(path.Ext(versionPath), "."))
+		if contentType := media_type.MediaType(url.ContentType); contentType.IsRaw() {
+			title = "RAW"
+		}
+
+		versions = append(versions, &models.MediaVersion{
+			Title:    title,
+			Primary:  (url.Purpose == models.MediaCounterpart) == counterpartIsPrimary,
+			MediaURL: url,
+		})
+	}
+
+	return versions, nil
+}
+
 func (r *mediaResolver) HighRes(ctx context.Context, media *models.Media) (*models.MediaURL, error) {
 	if media.Type != models.MediaTypePhoto {
 		return nil, nil
@@ -167,6 +224,30 @@ func (r *mediaResolver) VideoWeb(ctx context.Context, media *models.Media) (*mod
 	return dataloader.For(ctx).MediaVideoWeb.Load(media.ID)
 }
 
+func (r *mediaResolver) VideoHls(ctx context.Context, media *models.Media) (*models.MediaURL, error) {
+	if media.Type != models.MediaTypeVideo {
+		return nil, nil
+	}
+
+	return dataloader.For(ctx).MediaVideoHLS.Load(media.ID)
+}
+
+func (r *mediaResolver) VideoPreview(ctx context.Context, media *models.Media) (*models.MediaURL, error) {
+	if media.Type != models.MediaTypeVideo {
+		return nil, nil
+	}
+
+	return dataloader.For(ctx).MediaVideoPreview.Load(media.ID)
+}
+
+func (r *mediaResolver) MotionVideo(ctx context.Context, media *models.Media) (*models.MediaURL, error) {
+	if media.Type != models.MediaTypePhoto {
+		return nil, nil
+	}
+
+	return dataloader.For(ctx).MediaMotionVideo.Load(media.ID)
+}
+
 func (r *mediaResolver) Exif(ctx context.Context, media *models.Media) (*models.MediaEXIF, error) {
 	if media.Exif != nil {
 		return media.Exif, nil
@@ -180,6 +261,73 @@ func (r *mediaResolver) Exif(ctx context.Context, media *models.Media) (*models.
 	return &exif, nil
 }
 
+func (r *mediaResolver) Panorama(ctx context.Context, media *models.Media) (*models.MediaPanorama, error) {
+	if media.Panorama != nil {
+		return media.Panorama, nil
+	}
+
+	if media.PanoramaID == nil {
+		return nil, nil
+	}
+
+	var panorama models.MediaPanorama
+	if err := r.DB(ctx).First(&panorama, *media.PanoramaID).Error; err != nil {
+		return nil, errors.Wrapf(err, "get panorama of media (%d)", media.ID)
+	}
+
+	return &panorama, nil
+}
+
+func (r *mediaResolver) Stack(ctx context.Context, media *models.Media) (*models.MediaStack, error) {
+	if media.Stack != nil {
+		return media.Stack, nil
+	}
+
+	if media.StackID == nil {
+		return nil, nil
+	}
+
+	var stack models.MediaStack
+	if err := r.DB(ctx).First(&stack, *media.StackID).Error; err != nil {
+		return nil, errors.Wrapf(err, "get stack of media (%d)", media.ID)
+	}
+
+	return &stack, nil
+}
+
+func (r *mediaResolver) OriginalMedia(ctx context.Context, media *models.Media) (*models.Media, error) {
+	if media.OriginalMediaID == nil {
+		return nil, nil
+	}
+
+	var original models.Media
+	if err := r.DB(ctx).First(&original, *media.OriginalMediaID).Error; err != nil {
+		return nil, errors.Wrapf(err, "get original of media (%d)", media.ID)
+	}
+
+	return &original, nil
+}
+
+func (r *mediaResolver) OtherVersions(ctx context.Context, media *models.Media) ([]*models.Media, error) {
+	originalID := media.ID
+	if media.OriginalMediaID != nil {
+		originalID = *media.OriginalMediaID
+	}
+
+	var versions []*models.Media
+	err := r.DB(ctx).
+		Where("id = ? OR original_media_id = ?", originalID, originalID).
+		Where("id != ?", media.ID).
+		Order("date_shot, title").
+		Find(&versions).Error
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "get other versions of media (%d)", media.ID)
+	}
+
+	return versions, nil
+}
+
 func (r *mediaResolver) Favorite(ctx context.Context, media *models.Media) (bool, error) {
 	user := auth.UserFromContext(ctx)
 	if user == nil {
@@ -192,6 +340,10 @@ func (r *mediaResolver) Favorite(ctx context.Context, media *models.Media) (bool
 	})
 }
 
+func (r *mediaResolver) Rating(ctx context.Context, media *models.Media) (*models.MediaRating, error) {
+	return media.RatingForUser(r.DB(ctx), auth.UserFromContext(ctx))
+}
+
 func (r *mutationResolver) FavoriteMedia(ctx context.Context, mediaID int, favorite bool) (*models.Media, error) {
 
 	user := auth.UserFromContext(ctx)
@@ -202,6 +354,117 @@ func (r *mutationResolver) FavoriteMedia(ctx context.Context, mediaID int, favor
 	return user.FavoriteMedia(r.DB(ctx), mediaID, favorite)
 }
 
+func (r *mutationResolver) SetVideoPoster(ctx context.Context, mediaID int, timestamp *float64) (*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	db := r.DB(ctx)
+
+	media, err := actions.SetVideoPoster(db, user, mediaID, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	// The new thumbnail is generated by the scanner, as encoding long videos takes longer than a request may
+	if err := scanner_queue.AddMediaToQueue(media); err != nil {
+		return nil, errors.Wrap(err, "queue generation of new video thumbnail")
+	}
+
+	return media, nil
+}
+
+func (r *mutationResolver) UpdateMediaMetadata(ctx context.Context, mediaID int, title *string, description *string) (*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.UpdateMediaMetadata(r.DB(ctx), user, mediaID, title, description)
+}
+
+func (r *mutationResolver) SetMediaRating(ctx context.Context, mediaIDs []int, stars int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetMediaRating(r.DB(ctx), user, mediaIDs, stars)
+}
+
+func (r *mutationResolver) SetMediaFlag(ctx context.Context, mediaIDs []int, flag models.MediaFlag) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetMediaFlag(r.DB(ctx), user, mediaIDs, flag)
+}
+
+func (r *mutationResolver) SetMediaColorLabel(ctx context.Context, mediaIDs []int, colorLabel *string) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetMediaColorLabel(r.DB(ctx), user, mediaIDs, colorLabel)
+}
+
+func (r *mutationResolver) SetMediaDate(ctx context.Context, mediaIDs []int, date time.Time) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetMediaDate(r.DB(ctx), user, mediaIDs, date)
+}
+
+func (r *mutationResolver) ShiftMediaDate(ctx context.Context, mediaIDs []int, seconds int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.ShiftMediaDate(r.DB(ctx), user, mediaIDs, seconds)
+}
+
+func (r *mutationResolver) SetMediaLocation(ctx context.Context, mediaIDs []int, latitude float64, longitude float64) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.SetMediaLocation(r.DB(ctx), user, mediaIDs, latitude, longitude)
+}
+
+func (r *mutationResolver) CopyMediaLocation(ctx context.Context, mediaIDs []int, sourceMediaID int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.CopyMediaLocation(r.DB(ctx), user, mediaIDs, sourceMediaID)
+}
+
+func (r *mutationResolver) ResetMediaOverrides(ctx context.Context, mediaIDs []int, date bool, location bool) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.ResetMediaOverrides(r.DB(ctx), user, mediaIDs, date, location)
+}
+
+func (r *mutationResolver) GeotagAlbumFromGpx(ctx context.Context, albumID int, track graphql.Upload, maxGap *int, clockOffset *int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.GeotagAlbumFromGPX(r.DB(ctx), user, albumID, track.File, maxGap, clockOffset)
+}
+
 func (r *mediaResolver) Faces(ctx context.Context, media *models.Media) ([]*models.ImageFace, error) {
 	if face_detection.GlobalFaceDetector == nil {
 		return []*models.ImageFace{}, nil
@@ -218,3 +481,13 @@ func (r *mediaResolver) Faces(ctx context.Context, media *models.Media) ([]*mode
 
 	return faces, nil
 }
+
+func (r *mediaResolver) AllMetadata(ctx context.Context, media *models.Media, groups []string) ([]*models.MetadataTag, error) {
+	var rawMetadata models.MediaRawMetadata
+	result := r.DB(ctx).Where("media_id = ?", media.ID).Limit(1).Find(&rawMetadata)
+	if result.Error != nil {
+		return nil, errors.Wrapf(result.Error, "get all metadata of media (%d)", media.ID)
+	}
+
+	return rawMetadata.Tags.List(groups), nil
+}
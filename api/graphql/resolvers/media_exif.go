@@ -0,0 +1,30 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	api "github.com/photoview/photoview/api/graphql"
+	"github.com/photoview/photoview/api/graphql/models"
+)
+
+type mediaEXIFResolver struct {
+	*Resolver
+}
+
+func (r *Resolver) MediaEXIF() api.MediaEXIFResolver {
+	return &mediaEXIFResolver{r}
+}
+
+func (r *mediaEXIFResolver) Keywords(ctx context.Context, exif *models.MediaEXIF) ([]string, error) {
+	return exif.Keywords, nil
+}
+
+func (r *mediaEXIFResolver) DateShot(ctx context.Context, exif *models.MediaEXIF) (*time.Time, error) {
+	if exif.DateShot == nil || exif.DateShotOffset == nil {
+		return exif.DateShot, nil
+	}
+
+	date := models.LocalDate(*exif.DateShot, *exif.DateShotOffset)
+	return &date, nil
+}
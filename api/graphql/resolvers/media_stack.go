@@ -0,0 +1,49 @@
+package resolvers
+
+import (
+	"context"
+
+	api "github.com/photoview/photoview/api/graphql"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+)
+
+type mediaStackResolver struct {
+	*Resolver
+}
+
+func (r *Resolver) MediaStack() api.MediaStackResolver {
+	return &mediaStackResolver{r}
+}
+
+func (r *mediaStackResolver) Cover(ctx context.Context, stack *models.MediaStack) (*models.Media, error) {
+	var cover models.Media
+	if err := r.DB(ctx).First(&cover, stack.CoverID).Error; err != nil {
+		return nil, errors.Wrapf(err, "get cover of media stack (%d)", stack.ID)
+	}
+
+	return &cover, nil
+}
+
+func (r *mediaStackResolver) Media(ctx context.Context, stack *models.MediaStack) ([]*models.Media, error) {
+	var media []*models.Media
+	err := r.DB(ctx).
+		Where("stack_id = ?", stack.ID).
+		Order("date_shot, title").
+		Find(&media).Error
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "get media of stack (%d)", stack.ID)
+	}
+
+	return media, nil
+}
+
+func (r *mediaStackResolver) Count(ctx context.Context, stack *models.MediaStack) (int, error) {
+	var count int64
+	if err := r.DB(ctx).Model(&models.Media{}).Where("stack_id = ?", stack.ID).Count(&count).Error; err != nil {
+		return 0, errors.Wrapf(err, "count media of stack (%d)", stack.ID)
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,27 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/photoview/photoview/api/graphql/auth"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+)
+
+func (r *queryResolver) MyPlaces(ctx context.Context) ([]*models.Place, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.MyPlaces(r.DB(ctx), user)
+}
+
+func (r *queryResolver) MediaByPlace(ctx context.Context, country string, region *string, city *string, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.MediaByPlace(r.DB(ctx), user, country, region, city, order, paginate)
+}
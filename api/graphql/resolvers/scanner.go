@@ -2,12 +2,16 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/photoview/photoview/api/database/drivers"
 	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/notification"
+	"github.com/photoview/photoview/api/scanner"
 	"github.com/photoview/photoview/api/scanner/periodic_scanner"
 	"github.com/photoview/photoview/api/scanner/scanner_queue"
+	"github.com/photoview/photoview/api/scanner/scanner_utils"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -86,3 +90,60 @@ func (r *mutationResolver) SetScannerConcurrentWorkers(ctx context.Context, work
 
 	return siteInfo.ConcurrentWorkers, nil
 }
+
+func (r *mutationResolver) RefreshMetadata(ctx context.Context, mediaID *int, albumID *int) (*models.ScannerResult, error) {
+	if mediaID != nil && albumID != nil {
+		return nil, errors.New("only one of mediaId and albumId can be given")
+	}
+
+	if mediaID != nil {
+		var media models.Media
+		if err := r.DB(ctx).First(&media, *mediaID).Error; err != nil {
+			return nil, errors.Wrap(err, "get media from database")
+		}
+
+		if scanner.RefreshMetadata(r.DB(ctx), []*models.Media{&media}) == 0 {
+			return nil, errors.Errorf("refreshing the metadata of %s failed", media.Title)
+		}
+
+		message := "Metadata refreshed"
+		return &models.ScannerResult{
+			Finished: true,
+			Success:  true,
+			Message:  &message,
+		}, nil
+	}
+
+	if albumID != nil {
+		var album models.Album
+		if err := r.DB(ctx).First(&album, *albumID).Error; err != nil {
+			return nil, errors.Wrap(err, "get album from database")
+		}
+	}
+
+	// The request context ends before the refresh does
+	err := scanner.RefreshMetadataInBackground(r.database, albumID, func(refreshed int, err error) {
+		if err != nil {
+			scanner_utils.ScannerError("refresh metadata: %s", err)
+			return
+		}
+
+		notification.BroadcastNotification(&models.Notification{
+			Key:      "metadata-refresh",
+			Type:     models.NotificationTypeMessage,
+			Header:   "Metadata refreshed",
+			Content:  fmt.Sprintf("The metadata of %d media was read again", refreshed),
+			Positive: true,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	startMessage := "Metadata refresh started"
+	return &models.ScannerResult{
+		Finished: false,
+		Success:  true,
+		Message:  &startMessage,
+	}, nil
+}
@@ -9,11 +9,11 @@ import (
 	"github.com/photoview/photoview/api/graphql/models"
 )
 
-func (r *Resolver) Search(ctx context.Context, query string, limitMedia *int, limitAlbums *int) (*models.SearchResult, error) {
+func (r *Resolver) Search(ctx context.Context, query string, limitMedia *int, limitAlbums *int, ratingFilter *models.MediaRatingFilter) (*models.SearchResult, error) {
 	user := auth.UserFromContext(ctx)
 	if user == nil {
 		return nil, auth.ErrUnauthorized
 	}
 
-	return actions.Search(r.DB(ctx), query, user.ID, limitMedia, limitAlbums)
+	return actions.Search(r.DB(ctx), query, user.ID, limitMedia, limitAlbums, ratingFilter)
 }
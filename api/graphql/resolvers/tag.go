@@ -0,0 +1,116 @@
+package resolvers
+
+import (
+	"context"
+
+	api "github.com/photoview/photoview/api/graphql"
+	"github.com/photoview/photoview/api/graphql/auth"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+	"github.com/pkg/errors"
+)
+
+type tagResolver struct {
+	*Resolver
+}
+
+func (r *Resolver) Tag() api.TagResolver {
+	return &tagResolver{r}
+}
+
+func (r *tagResolver) Parent(ctx context.Context, tag *models.Tag) (*models.Tag, error) {
+	if tag.ParentID == nil {
+		return nil, nil
+	}
+
+	var parent models.Tag
+	if err := r.DB(ctx).First(&parent, *tag.ParentID).Error; err != nil {
+		return nil, errors.Wrapf(err, "get parent of tag (%d)", tag.ID)
+	}
+
+	return &parent, nil
+}
+
+func (r *tagResolver) Children(ctx context.Context, tag *models.Tag) ([]*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.TagChildren(r.DB(ctx), user, tag)
+}
+
+func (r *tagResolver) MediaCount(ctx context.Context, tag *models.Tag) (int, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return 0, nil
+	}
+
+	var count int64
+	err := r.DB(ctx).Model(&models.Media{}).
+		Where("media.album_id IN (SELECT user_albums.album_id FROM user_albums WHERE user_albums.user_id = ?)", user.ID).
+		Where("media.id IN (SELECT media_tags.media_id FROM media_tags WHERE media_tags.tag_id = ?)", tag.ID).
+		Count(&count).Error
+	if err != nil {
+		return 0, errors.Wrapf(err, "count media of tag (%d)", tag.ID)
+	}
+
+	return int(count), nil
+}
+
+func (r *mediaResolver) Tags(ctx context.Context, media *models.Media) ([]*models.Tag, error) {
+	var tags []*models.Tag
+	err := r.DB(ctx).
+		Where("id IN (SELECT tag_id FROM media_tags WHERE media_id = ?)", media.ID).
+		Order("path").
+		Find(&tags).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "get tags of media (%d)", media.ID)
+	}
+
+	return tags, nil
+}
+
+func (r *queryResolver) MyTags(ctx context.Context) ([]*models.Tag, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.MyTags(r.DB(ctx), user)
+}
+
+func (r *queryResolver) MediaByTag(ctx context.Context, tagID int, includeChildren *bool, order *models.Ordering, paginate *models.Pagination) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.MediaByTag(r.DB(ctx), user, tagID, includeChildren != nil && *includeChildren, order, paginate)
+}
+
+func (r *mutationResolver) AddMediaTag(ctx context.Context, mediaIDs []int, tag string) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.AddMediaTag(r.DB(ctx), user, mediaIDs, tag)
+}
+
+func (r *mutationResolver) RemoveMediaTag(ctx context.Context, mediaIDs []int, tagID int) ([]*models.Media, error) {
+	user := auth.UserFromContext(ctx)
+	if user == nil {
+		return nil, auth.ErrUnauthorized
+	}
+
+	return actions.RemoveMediaTag(r.DB(ctx), user, mediaIDs, tagID)
+}
+
+func (r *mutationResolver) RenameTag(ctx context.Context, tagID int, name string) (*models.Tag, error) {
+	return actions.RenameTag(r.DB(ctx), tagID, name)
+}
+
+func (r *mutationResolver) MergeTags(ctx context.Context, sourceTagID int, targetTagID int) (*models.Tag, error) {
+	return actions.MergeTags(r.DB(ctx), sourceTagID, targetTagID)
+}
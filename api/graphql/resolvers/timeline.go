@@ -9,11 +9,11 @@ import (
 	"github.com/photoview/photoview/api/graphql/models/actions"
 )
 
-func (r *queryResolver) MyTimeline(ctx context.Context, paginate *models.Pagination, onlyFavorites *bool, fromDate *time.Time) ([]*models.Media, error) {
+func (r *queryResolver) MyTimeline(ctx context.Context, paginate *models.Pagination, onlyFavorites *bool, fromDate *time.Time, collapseStacks *bool, allVersions *bool, ratingFilter *models.MediaRatingFilter) ([]*models.Media, error) {
 	user := auth.UserFromContext(ctx)
 	if user == nil {
 		return nil, auth.ErrUnauthorized
 	}
 
-	return actions.MyTimeline(r.DB(ctx), user, paginate, onlyFavorites, fromDate)
+	return actions.MyTimeline(r.DB(ctx), user, paginate, onlyFavorites, fromDate, collapseStacks, allVersions, ratingFilter)
 }
@@ -0,0 +1,53 @@
+package resolvers
+
+import (
+	"context"
+
+	api "github.com/photoview/photoview/api/graphql"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+)
+
+type videoMetadataResolver struct {
+	*Resolver
+}
+
+func (r *Resolver) VideoMetadata() api.VideoMetadataResolver {
+	return &videoMetadataResolver{r}
+}
+
+func (r *videoMetadataResolver) streams(ctx context.Context, metadata *models.VideoMetadata, streamType models.VideoStreamType) ([]*models.VideoStream, error) {
+	var streams []*models.VideoStream
+	err := r.DB(ctx).
+		Where("video_metadata_id = ? AND type = ?", metadata.ID, streamType).
+		Order("stream_index").
+		Find(&streams).Error
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "get %s streams of video metadata (%d)", streamType, metadata.ID)
+	}
+
+	return streams, nil
+}
+
+func (r *videoMetadataResolver) AudioStreams(ctx context.Context, metadata *models.VideoMetadata) ([]*models.VideoStream, error) {
+	return r.streams(ctx, metadata, models.VideoStreamAudio)
+}
+
+func (r *videoMetadataResolver) SubtitleStreams(ctx context.Context, metadata *models.VideoMetadata) ([]*models.VideoStream, error) {
+	return r.streams(ctx, metadata, models.VideoStreamSubtitle)
+}
+
+func (r *videoMetadataResolver) Chapters(ctx context.Context, metadata *models.VideoMetadata) ([]*models.VideoChapter, error) {
+	var chapters []*models.VideoChapter
+	err := r.DB(ctx).
+		Where("video_metadata_id = ?", metadata.ID).
+		Order("start_time").
+		Find(&chapters).Error
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "get chapters of video metadata (%d)", metadata.ID)
+	}
+
+	return chapters, nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// refreshMetadataCommand is the name of the command line command reading the metadata of existing media again
+const refreshMetadataCommand = "refresh-metadata"
+
+// runRefreshMetadataCommand refreshes the metadata of a media, of an album and the albums below it,
+// or of the whole library when neither is given:
+//
+//	photoview refresh-metadata [-media ID | -album ID]
+func runRefreshMetadataCommand(db *gorm.DB, args []string) error {
+	flags := flag.NewFlagSet(refreshMetadataCommand, flag.ContinueOnError)
+	mediaID := flags.Int("media", 0, "refresh the metadata of the media with this id")
+	albumID := flags.Int("album", 0, "refresh the metadata of the media of the album with this id, and of the albums below it")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *mediaID != 0 && *albumID != 0 {
+		return errors.New("only one of -media and -album can be given")
+	}
+
+	executable_worker.InitializeExecutableWorkers()
+	exif.InitializeEXIFParser()
+
+	var refreshed int
+	var err error
+	switch {
+	case *mediaID != 0:
+		var media models.Media
+		if err := db.First(&media, *mediaID).Error; err != nil {
+			return errors.Wrap(err, "get media from database")
+		}
+		refreshed = scanner.RefreshMetadata(db, []*models.Media{&media})
+	case *albumID != 0:
+		refreshed, err = scanner.RefreshAlbumMetadata(db, *albumID)
+	default:
+		refreshed, err = scanner.RefreshAllMetadata(db)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Refreshed the metadata of %d media\n", refreshed)
+	return nil
+}
@@ -0,0 +1,117 @@
+package routes
+
+import (
+	"bufio"
+	"bytes"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner"
+	"gorm.io/gorm"
+)
+
+func RegisterHLSRoutes(db *gorm.DB, router *mux.Router) {
+
+	router.HandleFunc("/{name}/{file}", func(w http.ResponseWriter, r *http.Request) {
+		mediaName := mux.Vars(r)["name"]
+		fileName := mux.Vars(r)["file"]
+
+		fileExt := path.Ext(fileName)
+		if fileName != path.Base(fileName) || (fileExt != ".m3u8" && fileExt != ".ts") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("404"))
+			return
+		}
+
+		var mediaURL models.MediaURL
+		result := db.Model(&models.MediaURL{}).Select("media_urls.*").Joins("Media").
+			Where("media_urls.media_name = ? AND media_urls.purpose = ?", mediaName, models.VideoHLS).
+			Find(&mediaURL)
+		if err := result.Error; err != nil || result.RowsAffected == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("404"))
+			return
+		}
+
+		var media = mediaURL.Media
+
+		if success, response, status, err := authenticateMedia(media, db, r); !success {
+			if err != nil {
+				log.Printf("WARN: error authenticating HLS stream: %s\n", err)
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(response))
+			return
+		}
+
+		streamPath, err := mediaURL.CachedPath()
+		if err != nil {
+			log.Printf("ERROR: %s\n", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("internal server error"))
+			return
+		}
+
+		masterPath := path.Join(streamPath, models.HLSMasterPlaylist)
+		if _, err := os.Stat(masterPath); os.IsNotExist(err) {
+			if err := scanner.ProcessSingleMedia(db, media); err != nil {
+				log.Printf("ERROR: processing HLS stream not found in cache: %s\n", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte("internal server error"))
+				return
+			}
+		}
+
+		cachedPath := path.Join(streamPath, fileName)
+		if _, err := os.Stat(cachedPath); err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("404"))
+			return
+		}
+
+		if fileExt == ".ts" {
+			w.Header().Set("Content-Type", "video/mp2t")
+			http.ServeFile(w, r, cachedPath)
+			return
+		}
+
+		playlist, err := os.ReadFile(cachedPath)
+		if err != nil {
+			log.Printf("ERROR: reading HLS playlist: %s\n", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("internal server error"))
+			return
+		}
+
+		// The playlist depends on the query of the request, so it must not be cached
+		w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Write(appendQueryToPlaylist(playlist, r.URL.RawQuery))
+	})
+}
+
+// appendQueryToPlaylist adds the query string to every uri in the playlist,
+// such that the share token used to request the playlist is also used for the files it references
+func appendQueryToPlaylist(playlist []byte, rawQuery string) []byte {
+	if rawQuery == "" {
+		return playlist
+	}
+
+	var result bytes.Buffer
+	lines := bufio.NewScanner(bytes.NewReader(playlist))
+	for lines.Scan() {
+		line := lines.Text()
+		if line != "" && !strings.HasPrefix(line, "#") {
+			line = line + "?" + rawQuery
+		}
+
+		result.WriteString(line + "\n")
+	}
+
+	return result.Bytes()
+}
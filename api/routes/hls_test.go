@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendQueryToPlaylist(t *testing.T) {
+	playlist := []byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=984000\n360p.m3u8\n\n#EXTINF:6.000000,\n360p_0000.ts\n")
+
+	assert.Equal(t, playlist, appendQueryToPlaylist(playlist, ""))
+	assert.Equal(t,
+		"#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=984000\n360p.m3u8?token=abc\n\n#EXTINF:6.000000,\n360p_0000.ts?token=abc\n",
+		string(appendQueryToPlaylist(playlist, "token=abc")),
+	)
+}
@@ -0,0 +1,125 @@
+package exif
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/timezone"
+	"github.com/pkg/errors"
+)
+
+// maxTimeOffset is the largest offset from UTC used by any timezone
+const maxTimeOffset = 14 * time.Hour
+
+var timeOffsetRegex = regexp.MustCompile(`^([+-])(\d{1,2}):?(\d{2})$`)
+
+// parseTimeOffset parses an offset from UTC such as `+09:00` or `-0530`, as written in the OffsetTime EXIF tags,
+// and returns it in seconds
+func parseTimeOffset(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "Z" {
+		return 0, nil
+	}
+
+	matches := timeOffsetRegex.FindStringSubmatch(value)
+	if matches == nil {
+		return 0, errors.Errorf("invalid time offset: %s", value)
+	}
+
+	hours, _ := strconv.Atoi(matches[2])
+	minutes, _ := strconv.Atoi(matches[3])
+	offset := hours*3600 + minutes*60
+
+	if time.Duration(offset)*time.Second > maxTimeOffset || minutes >= 60 {
+		return 0, errors.Errorf("invalid time offset: %s", value)
+	}
+
+	if matches[1] == "-" {
+		offset = -offset
+	}
+
+	return offset, nil
+}
+
+// offsetFromGPSTime returns the offset in seconds from UTC of the local time of capture, given the GPS time of capture which is in UTC.
+// The offset is rounded to 15 minutes, as the camera clock and the GPS time rarely agree to the second.
+// It returns nil if the difference is too large to be an offset, as happens when the camera clock is wrong.
+func offsetFromGPSTime(localTime time.Time, gpsTime time.Time) *int {
+	difference := wallClockTime(localTime).Sub(gpsTime.UTC())
+	if difference.Abs() > maxTimeOffset {
+		return nil
+	}
+
+	quarters := math.Round(difference.Minutes() / 15)
+	offset := int(quarters) * 15 * 60
+	return &offset
+}
+
+var gpsTimeSeparatorRegex = regexp.MustCompile(`[:\s]+`)
+
+// parseGPSTime combines the GPSDateStamp and GPSTimeStamp tags into the UTC time of the GPS fix.
+// The time is given as `hh:mm:ss` or as three numbers separated by spaces, the seconds can have decimals.
+func parseGPSTime(dateStamp string, timeStamp string) (time.Time, error) {
+	date, err := time.Parse("2006:01:02", strings.TrimSpace(dateStamp))
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "parse GPS date stamp")
+	}
+
+	parts := gpsTimeSeparatorRegex.Split(strings.TrimSpace(timeStamp), -1)
+	if len(parts) != 3 {
+		return time.Time{}, errors.Errorf("invalid GPS time stamp: %s", timeStamp)
+	}
+
+	var values [3]float64
+	for i, part := range parts {
+		if values[i], err = strconv.ParseFloat(part, 64); err != nil {
+			return time.Time{}, errors.Wrapf(err, "parse GPS time stamp (%s)", timeStamp)
+		}
+	}
+
+	seconds := values[0]*3600 + values[1]*60 + values[2]
+	return date.Add(time.Duration(seconds * float64(time.Second))), nil
+}
+
+// applyLocationTimezone sets the offset of the capture date from the timezone at the location of capture,
+// if the metadata did not give the offset
+func applyLocationTimezone(exif *models.MediaEXIF) {
+	if exif.DateShot == nil || exif.DateShotOffset != nil || exif.Coordinates() == nil {
+		return
+	}
+
+	location := timezone.Location(*exif.GPSLatitude, *exif.GPSLongitude)
+	if location == nil {
+		return
+	}
+
+	// The offset of the local time depends on daylight saving time at the date of capture
+	date := exif.DateShot
+	_, offset := time.Date(date.Year(), date.Month(), date.Day(), date.Hour(), date.Minute(), date.Second(), 0, location).Zone()
+	exif.DateShotOffset = &offset
+}
+
+// localTimeAt converts a date in UTC to the local time at the location of capture, using the timezone at the location if known
+// and the timezone of the server otherwise. The offset is only returned if the timezone at the location is known.
+func localTimeAt(date time.Time, latitude *float64, longitude *float64) (time.Time, *int) {
+	if latitude != nil && longitude != nil {
+		if location := timezone.Location(*latitude, *longitude); location != nil {
+			localDate := date.In(location)
+			_, offset := localDate.Zone()
+			return wallClockTime(localDate), &offset
+		}
+	}
+
+	return wallClockTime(date.In(time.Local)), nil
+}
+
+func equalOffsets(a *int, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
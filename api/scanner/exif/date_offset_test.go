@@ -0,0 +1,81 @@
+package exif_test
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// jpegWithExif returns a small JPEG image with the EXIF tags set by the function
+func jpegWithExif(t *testing.T, setTags func(writer *media_utils.ExifWriter)) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 8, 8)), nil))
+
+	writer := media_utils.NewExifWriter()
+	writer.SetASCII(media_utils.ExifSubIFD, 0x9003, "2024:05:01 09:30:00") // DateTimeOriginal
+	setTags(writer)
+
+	data, err := media_utils.ReplaceJpegMetadata(buf.Bytes(), []media_utils.JpegSegment{writer.Segment()})
+	assert.NoError(t, err)
+	return data
+}
+
+func TestDateOffset(t *testing.T) {
+	dir := t.TempDir()
+	localTime := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		setTags func(writer *media_utils.ExifWriter)
+		offset  *int
+	}{
+		{
+			name: "offset time",
+			setTags: func(writer *media_utils.ExifWriter) {
+				writer.SetASCII(media_utils.ExifSubIFD, 0x9010, "+02:00") // OffsetTime
+				writer.SetASCII(media_utils.ExifSubIFD, 0x9011, "+09:00") // OffsetTimeOriginal
+			},
+			offset: intPointer(9 * 3600),
+		},
+		{
+			name: "gps time",
+			setTags: func(writer *media_utils.ExifWriter) {
+				writer.SetASCII(media_utils.ExifGPSIFD, 0x001D, "2024:05:01")         // GPSDateStamp
+				writer.SetRationals(media_utils.ExifGPSIFD, 0x0007, [2]uint32{14, 1}, // GPSTimeStamp
+					[2]uint32{32, 1}, [2]uint32{5, 1})
+			},
+			offset: intPointer(-5 * 3600),
+		},
+		{
+			name:    "unknown offset",
+			setTags: func(writer *media_utils.ExifWriter) {},
+			offset:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mediaPath := path.Join(dir, test.name+".jpg")
+			assert.NoError(t, os.WriteFile(mediaPath, jpegWithExif(t, test.setTags), 0644))
+
+			parsedExif, err := exif.NewInternalExifParser().ParseExif(mediaPath)
+			assert.NoError(t, err)
+			if assert.NotNil(t, parsedExif) {
+				assert.Equal(t, localTime, *parsedExif.DateShot)
+				assert.Equal(t, test.offset, parsedExif.DateShotOffset)
+			}
+		})
+	}
+}
+
+func intPointer(value int) *int {
+	return &value
+}
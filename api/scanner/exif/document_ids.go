@@ -0,0 +1,40 @@
+package exif
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ParseDocumentIDs returns the XMP document id of a file, and the id of the document it was derived from,
+// as written by photo editors when exporting an edited copy
+func ParseDocumentIDs(properties XMPProperties) (documentID *string, derivedFrom *string) {
+	if value, found := properties.Get(xmpNamespaceMM, "DocumentID"); found && value != "" {
+		documentID = &value
+	}
+
+	if value, found := properties.GetField(xmpNamespaceMM, "DerivedFrom", xmpNamespaceStRef, "documentID"); found && value != "" {
+		derivedFrom = &value
+	} else if value, found := properties.Get(xmpNamespaceMM, "OriginalDocumentID"); found && value != "" {
+		// The original document id is kept through all edits, and only differs from the document id for copies
+		if documentID == nil || value != *documentID {
+			derivedFrom = &value
+		}
+	}
+
+	return documentID, derivedFrom
+}
+
+// ReadDocumentIDs reads the XMP document ids embedded in the media file
+func ReadDocumentIDs(mediaPath string) (documentID *string, derivedFrom *string, err error) {
+	packet, err := ReadEmbeddedXMP(mediaPath)
+	if err != nil || packet == nil {
+		return nil, nil, err
+	}
+
+	properties, err := ParseXMPProperties(packet)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "read document ids (%s)", mediaPath)
+	}
+
+	documentID, derivedFrom = ParseDocumentIDs(properties)
+	return documentID, derivedFrom, nil
+}
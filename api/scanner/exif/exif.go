@@ -2,9 +2,12 @@ package exif
 
 import (
 	"log"
+	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/photoview/photoview/api/graphql/models"
 )
@@ -13,6 +16,11 @@ type ExifParser interface {
 	ParseExif(media_path string) (*models.MediaEXIF, error)
 }
 
+// MetadataDumper is implemented by exif parsers that can read every metadata tag of a media file
+type MetadataDumper interface {
+	DumpMetadata(media_path string) (models.RawMetadata, error)
+}
+
 var globalExifParser ExifParser
 
 func InitializeEXIFParser() {
@@ -28,6 +36,16 @@ func InitializeEXIFParser() {
 	}
 }
 
+// ParseEXIF scans the media file for exif metadata, without saving it to the database
+func ParseEXIF(mediaPath string) (*models.MediaEXIF, error) {
+	parser := globalExifParser
+	if parser == nil {
+		parser = NewInternalExifParser()
+	}
+
+	return parser.ParseExif(mediaPath)
+}
+
 // SaveEXIF scans the media file for exif metadata and saves it in the database if found
 func SaveEXIF(tx *gorm.DB, media *models.Media) (*models.MediaEXIF, error) {
 
@@ -44,6 +62,15 @@ func SaveEXIF(tx *gorm.DB, media *models.Media) (*models.MediaEXIF, error) {
 		}
 	}
 
+	return saveParsedEXIF(tx, media)
+}
+
+// ReimportEXIF parses the metadata of the media file and its sidecar again, replacing the stored metadata
+func ReimportEXIF(tx *gorm.DB, media *models.Media) (*models.MediaEXIF, error) {
+	return saveParsedEXIF(tx, media)
+}
+
+func saveParsedEXIF(tx *gorm.DB, media *models.Media) (*models.MediaEXIF, error) {
 	if globalExifParser == nil {
 		return nil, errors.New("No exif parser initialized")
 	}
@@ -53,17 +80,86 @@ func SaveEXIF(tx *gorm.DB, media *models.Media) (*models.MediaEXIF, error) {
 		return nil, errors.Wrap(err, "failed to parse exif data")
 	}
 
+	// Values from XMP, written by photo managers, take precedence over the values written by the camera
+	xmpMetadata, err := ReadXMPMetadata(media.Path, media.SideCarPath)
+	if err != nil {
+		log.Printf("WARN: reading XMP metadata of %s failed: %s\n", media.Title, err)
+	}
+
+	if xmpMetadata != nil {
+		if exif == nil {
+			exif = &models.MediaEXIF{}
+		}
+		xmpMetadata.ApplyTo(exif)
+	}
+
+	// Dates and locations corrected by the user take precedence over all metadata of the file
+	override, err := models.FindMediaOverride(tx, media.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if override != nil && override.LocationFromTrack && exif != nil && exif.Coordinates() != nil {
+		// A location interpolated from a GPX track is replaced by one added to the file later, such as by a photo manager
+		if err := removeTrackLocation(tx, override); err != nil {
+			return nil, err
+		}
+	}
+
+	if override != nil {
+		if exif == nil {
+			exif = &models.MediaEXIF{}
+		}
+		override.ApplyTo(exif)
+	}
+
+	saveRawMetadata(tx, media)
+
 	if exif == nil {
-		return nil, nil
+		if media.ExifID == nil {
+			return nil, nil
+		}
+
+		// The file no longer has metadata, so the values stored before are cleared
+		exif = &models.MediaEXIF{}
+	}
+
+	applyLocationTimezone(exif)
+	applyPlace(exif)
+
+	if media.ExifID != nil {
+		// Replace all values of the existing row, including the ones no longer present
+		exif.ID = *media.ExifID
+		if err := tx.Model(exif).Select("*").Omit("id", "created_at").Updates(exif).Error; err != nil {
+			return nil, errors.Wrap(err, "update media exif in database")
+		}
+	} else {
+		// Add EXIF to database and link to media
+		if err := tx.Model(&media).Association("Exif").Replace(exif); err != nil {
+			return nil, errors.Wrap(err, "save media exif to database")
+		}
+	}
+
+	if err := models.ReplaceImportedTags(tx, media.ID, tagPathsFromKeywords(exif.Keywords, exif.HierarchicalKeywords)); err != nil {
+		return nil, errors.Wrap(err, "import media tags")
 	}
 
-	// Add EXIF to database and link to media
-	if err := tx.Model(&media).Association("Exif").Replace(exif); err != nil {
-		return nil, errors.Wrap(err, "save media exif to database")
+	dateShot, dateShotOffset := exif.DateShot, exif.DateShotOffset
+	if dateShot == nil {
+		// Without a capture date the media is dated by its file, as when it was first scanned
+		fileInfo, err := os.Stat(media.Path)
+		if err != nil {
+			log.Printf("WARN: could not read file date of %s: %s\n", media.Title, err)
+			return exif, nil
+		}
+
+		modTime := fileInfo.ModTime()
+		dateShot, dateShotOffset = &modTime, nil
 	}
 
-	if exif.DateShot != nil && !exif.DateShot.Equal(media.DateShot) {
-		media.DateShot = *exif.DateShot
+	if !dateShot.Equal(media.DateShot) || !equalOffsets(dateShotOffset, media.DateShotOffset) {
+		media.DateShot = *dateShot
+		media.DateShotOffset = dateShotOffset
 		if err := tx.Save(media).Error; err != nil {
 			return nil, errors.Wrap(err, "update media date_shot")
 		}
@@ -71,3 +167,90 @@ func SaveEXIF(tx *gorm.DB, media *models.Media) (*models.MediaEXIF, error) {
 
 	return exif, nil
 }
+
+// removeTrackLocation removes the location of the overrides, and the overrides themselves once none are left
+func removeTrackLocation(tx *gorm.DB, override *models.MediaOverride) error {
+	override.GPSLatitude = nil
+	override.GPSLongitude = nil
+	override.LocationFromTrack = false
+
+	var err error
+	if override.IsEmpty() {
+		err = tx.Delete(override).Error
+	} else {
+		err = tx.Model(override).Select(models.MediaOverrideLocationColumns).Updates(override).Error
+	}
+	if err != nil {
+		return errors.Wrap(err, "remove location from track of media overrides")
+	}
+
+	return nil
+}
+
+// saveRawMetadata saves all metadata tags of the media file, if the exif parser can read them.
+// The dump is only used for inspection, so failures are logged without failing the import of the metadata.
+func saveRawMetadata(tx *gorm.DB, media *models.Media) {
+	dumper, ok := globalExifParser.(MetadataDumper)
+	if !ok {
+		return
+	}
+
+	metadata, err := dumper.DumpMetadata(media.Path)
+	if err != nil {
+		log.Printf("WARN: reading all metadata of %s failed: %s\n", media.Title, err)
+		return
+	}
+	if metadata == nil {
+		return
+	}
+
+	rawMetadata := models.MediaRawMetadata{
+		MediaID: media.ID,
+		Tags:    metadata,
+	}
+
+	err = tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "media_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"tags", "updated_at"}),
+	}).Create(&rawMetadata).Error
+	if err != nil {
+		log.Printf("WARN: saving all metadata of %s failed: %s\n", media.Title, err)
+	}
+}
+
+// tagPathsFromKeywords returns the paths of the tags of the keywords. Keywords that are also part of a hierarchical keyword
+// are left out, as Lightroom writes the names of all levels of hierarchical keywords as plain keywords too.
+func tagPathsFromKeywords(keywords []string, hierarchicalKeywords []string) [][]string {
+	tagPaths := make([][]string, 0)
+	seenPaths := make(map[string]bool)
+	levelNames := make(map[string]bool)
+
+	for _, keyword := range hierarchicalKeywords {
+		names := models.SplitTagPath(keyword)
+		if len(names) == 0 {
+			continue
+		}
+
+		path := strings.Join(names, models.TagPathSeparator)
+		if !seenPaths[path] {
+			seenPaths[path] = true
+			tagPaths = append(tagPaths, names)
+		}
+
+		for _, name := range names {
+			levelNames[name] = true
+		}
+	}
+
+	for _, keyword := range keywords {
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" || seenPaths[keyword] || levelNames[keyword] || strings.Contains(keyword, models.TagPathSeparator) {
+			continue
+		}
+
+		seenPaths[keyword] = true
+		tagPaths = append(tagPaths, []string{keyword})
+	}
+
+	return tagPaths
+}
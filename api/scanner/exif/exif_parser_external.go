@@ -1,8 +1,10 @@
 package exif
 
 import (
+	"fmt"
 	"log"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/barasher/go-exiftool"
@@ -13,21 +15,42 @@ import (
 type externalExifParser struct {
 	et         *exiftool.Exiftool
 	dataLoader *dataloader.ExiftoolLoader
+	// dumpTool reads all tags with their group names and human readable values, for the full metadata dump
+	dumpTool *exiftool.Exiftool
 }
 
-func NewExiftoolParser() (ExifParser, error) {
+// newExiftool starts exiftool in no print conversion mode, with a buffer large enough for the metadata of RAW files
+func newExiftool() (*exiftool.Exiftool, error) {
 	buf := make([]byte, 256*1024)
+	return exiftool.NewExiftool(exiftool.NoPrintConversion(), exiftool.Buffer(buf, 1024*1024))
+}
 
-	et, err := exiftool.NewExiftool(exiftool.NoPrintConversion(), exiftool.Buffer(buf, 64*1024))
+// newDumpExiftool starts exiftool printing the tags with their family 0 group name and human readable values,
+// as the values of the full metadata dump are shown to users
+func newDumpExiftool() (*exiftool.Exiftool, error) {
+	buf := make([]byte, 256*1024)
+	return exiftool.NewExiftool(exiftool.PrintGroupNames("0"), exiftool.Buffer(buf, 1024*1024))
+}
+
+func NewExiftoolParser() (ExifParser, error) {
+	et, err := newExiftool()
 
 	if err != nil {
 		log.Printf("Error initializing ExifTool: %s\n", err)
 		return nil, err
 	}
 
+	dumpTool, err := newDumpExiftool()
+	if err != nil {
+		log.Printf("Error initializing ExifTool for metadata dumps: %s\n", err)
+		et.Close()
+		return nil, err
+	}
+
 	return &externalExifParser{
 		et:         et,
 		dataLoader: dataloader.NewExiftoolLoader(et),
+		dumpTool:   dumpTool,
 	}, nil
 }
 
@@ -56,6 +79,9 @@ func sanitizeEXIF(exif *models.MediaEXIF) {
 	if exif.FocalLength != nil && !isFloatReal(*exif.FocalLength) {
 		exif.FocalLength = nil
 	}
+	if exif.BracketValue != nil && !isFloatReal(*exif.BracketValue) {
+		exif.BracketValue = nil
+	}
 	if (exif.GPSLatitude != nil && !isFloatReal(*exif.GPSLatitude)) ||
 		(exif.GPSLongitude != nil && !isFloatReal(*exif.GPSLongitude)) {
 		exif.GPSLatitude = nil
@@ -91,7 +117,7 @@ func extractValidGpsData(fileInfo *exiftool.FileMetadata, media_path string) (*f
 func (p *externalExifParser) ParseExif(media_path string) (returnExif *models.MediaEXIF, returnErr error) {
 	// ExifTool - No print conversion mode
 	if p.et == nil {
-		et, err := exiftool.NewExiftool(exiftool.NoPrintConversion())
+		et, err := newExiftool()
 		p.et = et
 
 		if err != nil {
@@ -100,7 +126,9 @@ func (p *externalExifParser) ParseExif(media_path string) (returnExif *models.Me
 		}
 	}
 
+	// The metadata is read again the next time, as the file may have changed when it is refreshed
 	fileInfo, err := p.dataLoader.Load(media_path)
+	p.dataLoader.Clear(media_path)
 	if err != nil {
 		return nil, err
 	}
@@ -146,12 +174,17 @@ func (p *externalExifParser) ParseExif(media_path string) (returnExif *models.Me
 			if err == nil {
 				found_exif = true
 				newExif.DateShot = &dateTime
+				newExif.DateShotOffset = p.readTimeOffset(&fileInfo, createDateKey)
 			} else {
 				layoutWithOffset := "2006:01:02 15:04:05-07:00"
 				dateTime, err = time.Parse(layoutWithOffset, date)
 				if err == nil {
 					found_exif = true
-					newExif.DateShot = &dateTime
+					// Dates are stored as the local time of the place of capture, together with the offset
+					_, offset := dateTime.Zone()
+					localTime := wallClockTime(dateTime)
+					newExif.DateShot = &localTime
+					newExif.DateShotOffset = &offset
 				}
 			}
 			break
@@ -207,12 +240,60 @@ func (p *externalExifParser) ParseExif(media_path string) (returnExif *models.Me
 		newExif.ExposureProgram = &expProgram
 	}
 
+	// Get burst id, written by Apple in the MakerNote and by Google in the XMP metadata
+	for _, burstKey := range []string{"BurstUUID", "BurstID"} {
+		burstID, err := fileInfo.GetString(burstKey)
+		if err == nil && burstID != "" {
+			found_exif = true
+			newExif.BurstID = &burstID
+			break
+		}
+	}
+
+	// Get exposure bracket value, the tag name depends on the camera maker
+	for _, bracketKey := range []string{"AEBBracketValue", "ExposureBracketValue", "BracketValue"} {
+		bracketValue, err := fileInfo.GetFloat(bracketKey)
+		if err == nil {
+			found_exif = true
+			newExif.BracketValue = &bracketValue
+			break
+		}
+	}
+
+	// Get sequence number within a burst or bracket
+	sequenceNumber, err := fileInfo.GetInt("SequenceNumber")
+	if err == nil {
+		found_exif = true
+		newExif.SequenceNumber = &sequenceNumber
+	}
+
+	// Get rating
+	rating, err := fileInfo.GetInt("Rating")
+	if err == nil {
+		found_exif = true
+		newExif.Rating = &rating
+	}
+
+	// Get IPTC keywords
+	keywords, err := fileInfo.GetStrings("Keywords")
+	if err == nil && len(keywords) > 0 {
+		found_exif = true
+		newExif.Keywords = keywords
+	}
+
 	// Get GPS data
 	newExif.GPSLatitude, newExif.GPSLongitude = extractValidGpsData(&fileInfo, media_path)
 	if (newExif.GPSLatitude != nil) && (newExif.GPSLongitude != nil) {
 		found_exif = true
 	}
 
+	// Derive the offset of the capture date from the GPS time, which is in UTC
+	if newExif.DateShot != nil && newExif.DateShotOffset == nil {
+		if gpsTime, err := p.readGPSTime(&fileInfo); err == nil {
+			newExif.DateShotOffset = offsetFromGPSTime(*newExif.DateShot, gpsTime)
+		}
+	}
+
 	if !found_exif {
 		return nil, nil
 	}
@@ -221,3 +302,84 @@ func (p *externalExifParser) ParseExif(media_path string) (returnExif *models.Me
 	sanitizeEXIF(returnExif)
 	return
 }
+
+// DumpMetadata returns all metadata tags of the media file read by exiftool, grouped by their family 0 group name.
+// The values are printed as exiftool shows them, such as `Multi-segment` for the metering mode instead of its code.
+func (p *externalExifParser) DumpMetadata(media_path string) (models.RawMetadata, error) {
+	fileInfo := p.dumpTool.ExtractMetadata(media_path)[0]
+	if fileInfo.Err != nil {
+		return nil, fileInfo.Err
+	}
+
+	metadata := make(models.RawMetadata)
+	for key, value := range fileInfo.Fields {
+		group, name, found := strings.Cut(key, ":")
+		if !found {
+			// SourceFile has no group
+			continue
+		}
+
+		metadata.Set(group, name, rawMetadataValue(value))
+	}
+
+	return metadata, nil
+}
+
+// rawMetadataValue formats a value decoded from the JSON output of exiftool
+func rawMetadataValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case []interface{}:
+		values := make([]string, len(value))
+		for i, item := range value {
+			values[i] = rawMetadataValue(item)
+		}
+		return strings.Join(values, ", ")
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// Tags holding the offset from UTC of each date tag, the offset of the modification date is used if the specific one is missing
+var exiftoolTimeOffsetKeys = map[string][]string{
+	"DateTimeOriginal": {"OffsetTimeOriginal", "OffsetTime"},
+	"CreateDate":       {"OffsetTimeDigitized", "OffsetTimeOriginal", "OffsetTime"},
+	"ModifyDate":       {"OffsetTime"},
+}
+
+// readTimeOffset returns the offset in seconds from UTC of the given date tag, or nil if the metadata has none
+func (p *externalExifParser) readTimeOffset(fileInfo *exiftool.FileMetadata, dateKey string) *int {
+	for _, offsetKey := range exiftoolTimeOffsetKeys[dateKey] {
+		value, err := fileInfo.GetString(offsetKey)
+		if err != nil {
+			continue
+		}
+
+		if offset, err := parseTimeOffset(value); err == nil {
+			return &offset
+		}
+	}
+
+	return nil
+}
+
+// readGPSTime returns the time in UTC of the GPS fix of the media
+func (p *externalExifParser) readGPSTime(fileInfo *exiftool.FileMetadata) (time.Time, error) {
+	if gpsDateTime, err := fileInfo.GetString("GPSDateTime"); err == nil {
+		dateStamp, timeStamp, _ := strings.Cut(strings.TrimSuffix(gpsDateTime, "Z"), " ")
+		return parseGPSTime(dateStamp, timeStamp)
+	}
+
+	dateStamp, err := fileInfo.GetString("GPSDateStamp")
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	timeStamp, err := fileInfo.GetString("GPSTimeStamp")
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return parseGPSTime(dateStamp, timeStamp)
+}
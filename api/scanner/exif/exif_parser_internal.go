@@ -6,12 +6,15 @@ import (
 	"math"
 	"math/big"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/photoview/photoview/api/graphql/models"
 	"github.com/pkg/errors"
 	"github.com/xor-gate/goexif2/exif"
 	"github.com/xor-gate/goexif2/mknote"
+	"github.com/xor-gate/goexif2/tiff"
 )
 
 // internalExifParser is an exif parser that parses the media without the use of external tools
@@ -67,9 +70,10 @@ func (p internalExifParser) ParseExif(media_path string) (returnExif *models.Med
 
 	date, err := exifTags.DateTime()
 	if err == nil {
-		_, tz := date.Zone()
-		date_utc := date.Add(time.Duration(tz) * time.Second).UTC()
-		newExif.DateShot = &date_utc
+		// Dates are stored as the local time of the place of capture, together with the offset if it is known
+		localTime := wallClockTime(date)
+		newExif.DateShot = &localTime
+		newExif.DateShotOffset = p.readDateOffset(exifTags, date, media_path)
 	}
 
 	exposureTag, err := exifTags.Get(exif.ExposureTime)
@@ -141,6 +145,13 @@ func (p internalExifParser) ParseExif(media_path string) (returnExif *models.Med
 		newExif.ExposureProgram = &exposureProgram64
 	}
 
+	keywords, err := ReadIPTCKeywords(media_path)
+	if err != nil {
+		log.Printf("WARN: could not read IPTC keywords of %s: %s\n", media_path, err)
+	} else if len(keywords) > 0 {
+		newExif.Keywords = keywords
+	}
+
 	lat, long, err := exifTags.LatLong()
 	if err == nil {
 		if math.Abs(lat) > 90 || math.Abs(long) > 90 {
@@ -159,6 +170,53 @@ func (p internalExifParser) ParseExif(media_path string) (returnExif *models.Med
 	return
 }
 
+// readDateOffset returns the offset in seconds from UTC of the capture date, read from the OffsetTime tags,
+// the Canon timezone or the GPS time in that order. It returns nil if none of them are present.
+func (p *internalExifParser) readDateOffset(tags *exif.Exif, date time.Time, media_path string) *int {
+	offsets, err := readEXIFTimeOffsets(media_path)
+	if err != nil {
+		log.Printf("WARN: could not read EXIF time offsets of %s: %s\n", media_path, err)
+	}
+
+	for _, offsetTag := range []uint16{tiffTagOffsetTimeOriginal, tiffTagOffsetTime} {
+		if offset, err := parseTimeOffset(offsets[offsetTag]); err == nil {
+			return &offset
+		}
+	}
+
+	// DateTime() parses the date in the Canon timezone if present, and in the timezone of the server otherwise
+	if timeZone, err := tags.TimeZone(); err == nil && timeZone != nil {
+		_, offset := date.Zone()
+		return &offset
+	}
+
+	gpsDate, err := p.readStringTag(tags, exif.GPSDateStamp, media_path)
+	if err != nil {
+		return nil
+	}
+
+	gpsTimeTag, err := tags.Get(exif.GPSTimeStamp)
+	if err != nil || gpsTimeTag.Count != 3 {
+		return nil
+	}
+
+	gpsTimeParts := make([]string, 3)
+	for i := range gpsTimeParts {
+		value, err := gpsTimeTag.Rat(i)
+		if err != nil {
+			return nil
+		}
+		gpsTimeParts[i] = value.FloatString(3)
+	}
+
+	gpsTime, err := parseGPSTime(*gpsDate, strings.Join(gpsTimeParts, " "))
+	if err != nil {
+		return nil
+	}
+
+	return offsetFromGPSTime(date, gpsTime)
+}
+
 func (p *internalExifParser) readStringTag(tags *exif.Exif, name exif.FieldName, media_path string) (*string, error) {
 	tag, err := tags.Get(name)
 	if err != nil {
@@ -215,3 +273,164 @@ func (p *internalExifParser) readIntegerTag(tags *exif.Exif, name exif.FieldName
 	log.Printf("WARN: EXIF tag %s returned null: %s\n", name, media_path)
 	return nil, errors.New("exif tag returned null")
 }
+
+// DumpMetadata returns all EXIF tags of the media file, the internal parser can not read the other metadata groups
+func (p internalExifParser) DumpMetadata(media_path string) (returnMetadata models.RawMetadata, returnErr error) {
+	photoFile, err := os.Open(media_path)
+	if err != nil {
+		return nil, err
+	}
+	defer photoFile.Close()
+
+	exif.RegisterParsers(mknote.All...)
+
+	// Recover if exif.Decode panics
+	defer func() {
+		if err := recover(); err != nil {
+			returnErr = errors.Errorf("Exif decoding panicked: %s", err)
+		}
+	}()
+
+	exifTags, err := exif.Decode(photoFile)
+	if err != nil {
+		return nil, nil
+	}
+
+	metadata := make(models.RawMetadata)
+	err = exifTags.Walk(exif.WalkerFunc(func(name exif.FieldName, tag *tiff.Tag) error {
+		if tag.Format() == tiff.UndefVal && len(tag.Val) > 64 {
+			metadata.Set("EXIF", string(name), fmt.Sprintf("(Binary data %d bytes)", len(tag.Val)))
+			return nil
+		}
+
+		metadata.Set("EXIF", string(name), internalTagValue(name, tag))
+		return nil
+	}))
+	if err != nil {
+		return nil, errors.Wrap(err, "read EXIF tags")
+	}
+
+	return metadata, nil
+}
+
+// internalTagValue formats the values of an EXIF tag, separated by commas.
+// The codes of tags with a fixed set of values are replaced by their names, as exiftool prints them.
+func internalTagValue(name exif.FieldName, tag *tiff.Tag) string {
+	if names, ok := exifValueNames[name]; ok && tag.Format() == tiff.IntVal && tag.Count == 1 {
+		if value, err := tag.Int64(0); err == nil {
+			if valueName, ok := names[value]; ok {
+				return valueName
+			}
+		}
+	}
+
+	values := make([]string, 0, tag.Count)
+
+	for i := 0; i < int(tag.Count); i++ {
+		switch tag.Format() {
+		case tiff.IntVal:
+			if value, err := tag.Int64(i); err == nil {
+				values = append(values, strconv.FormatInt(value, 10))
+			}
+		case tiff.RatVal:
+			if num, denom, err := tag.Rat2(i); err == nil {
+				values = append(values, fmt.Sprintf("%d/%d", num, denom))
+			}
+		case tiff.FloatVal:
+			if value, err := tag.Float(i); err == nil {
+				values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+			}
+		default:
+			if value, err := tag.StringVal(); err == nil {
+				return value
+			}
+			return tag.String()
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// exifValueNames are the names of the codes of EXIF tags with a fixed set of values
+var exifValueNames = map[exif.FieldName]map[int64]string{
+	exif.Orientation: {
+		1: "Horizontal (normal)",
+		2: "Mirror horizontal",
+		3: "Rotate 180",
+		4: "Mirror vertical",
+		5: "Mirror horizontal and rotate 270 CW",
+		6: "Rotate 90 CW",
+		7: "Mirror horizontal and rotate 90 CW",
+		8: "Rotate 270 CW",
+	},
+	exif.ExposureProgram: {
+		0: "Not Defined",
+		1: "Manual",
+		2: "Program AE",
+		3: "Aperture-priority AE",
+		4: "Shutter speed priority AE",
+		5: "Creative (Slow speed)",
+		6: "Action (High speed)",
+		7: "Portrait",
+		8: "Landscape",
+	},
+	exif.MeteringMode: {
+		0:   "Unknown",
+		1:   "Average",
+		2:   "Center-weighted average",
+		3:   "Spot",
+		4:   "Multi-spot",
+		5:   "Multi-segment",
+		6:   "Partial",
+		255: "Other",
+	},
+	exif.Flash: {
+		0x00: "No Flash",
+		0x01: "Fired",
+		0x05: "Fired, Return not detected",
+		0x07: "Fired, Return detected",
+		0x08: "On, Did not fire",
+		0x09: "On, Fired",
+		0x0d: "On, Return not detected",
+		0x0f: "On, Return detected",
+		0x10: "Off, Did not fire",
+		0x14: "Off, Did not fire, Return not detected",
+		0x18: "Auto, Did not fire",
+		0x19: "Auto, Fired",
+		0x1d: "Auto, Fired, Return not detected",
+		0x1f: "Auto, Fired, Return detected",
+		0x20: "No flash function",
+		0x30: "Off, No flash function",
+		0x41: "Fired, Red-eye reduction",
+		0x45: "Fired, Red-eye reduction, Return not detected",
+		0x47: "Fired, Red-eye reduction, Return detected",
+		0x49: "On, Red-eye reduction",
+		0x4d: "On, Red-eye reduction, Return not detected",
+		0x4f: "On, Red-eye reduction, Return detected",
+		0x50: "Off, Red-eye reduction",
+		0x58: "Auto, Did not fire, Red-eye reduction",
+		0x59: "Auto, Fired, Red-eye reduction",
+		0x5d: "Auto, Fired, Red-eye reduction, Return not detected",
+		0x5f: "Auto, Fired, Red-eye reduction, Return detected",
+	},
+	exif.ColorSpace: {
+		0x1:    "sRGB",
+		0x2:    "Adobe RGB",
+		0xffff: "Uncalibrated",
+	},
+	exif.ExposureMode: {
+		0: "Auto",
+		1: "Manual",
+		2: "Auto bracket",
+	},
+	exif.WhiteBalance: {
+		0: "Auto",
+		1: "Manual",
+	},
+	exif.SceneCaptureType: {
+		0: "Standard",
+		1: "Landscape",
+		2: "Portrait",
+		3: "Night",
+	},
+}
@@ -151,3 +151,37 @@ func TestExifParsers(t *testing.T) {
 // 		assert.Equal(t, exif, &bird_exif)
 // 	}
 // }
+
+func TestDumpMetadata(t *testing.T) {
+	test_utils.FilesystemTest(t)
+
+	dumper := exif.NewInternalExifParser().(exif.MetadataDumper)
+
+	metadata, err := dumper.DumpMetadata("./test_data/bird.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, "Canon EOS 600D", metadata["EXIF"]["Model"])
+	assert.Equal(t, "Photo of a Bird", metadata["EXIF"]["ImageDescription"])
+	assert.Equal(t, "1/4000", metadata["EXIF"]["ExposureTime"])
+	assert.Equal(t, "65/1, 1/1, 53/100", metadata["EXIF"]["GPSLatitude"])
+	assert.Equal(t, "Off, Did not fire", metadata["EXIF"]["Flash"], "codes are replaced by their names")
+	assert.Equal(t, "sRGB", metadata["EXIF"]["ColorSpace"])
+
+	metadata, err = dumper.DumpMetadata("./test_data/stripped.jpg")
+	assert.NoError(t, err)
+	assert.Empty(t, metadata)
+}
+
+func TestDumpMetadataExiftool(t *testing.T) {
+	test_utils.FilesystemTest(t)
+
+	parser, err := exif.NewExiftoolParser()
+	if err != nil {
+		t.Skip("failed to get exiftool, skipping test")
+	}
+
+	metadata, err := parser.(exif.MetadataDumper).DumpMetadata("./test_data/bird.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, "Canon EOS 600D", metadata["EXIF"]["Model"])
+	assert.Equal(t, "Off, Did not fire", metadata["EXIF"]["Flash"], "the values are print converted")
+	assert.Equal(t, "sRGB", metadata["EXIF"]["ColorSpace"])
+}
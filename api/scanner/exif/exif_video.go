@@ -0,0 +1,247 @@
+package exif
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gopkg.in/vansante/go-ffprobe.v2"
+	"gorm.io/gorm"
+)
+
+// Container tags holding the capture date, where the ones including a timezone offset are preferred
+var videoDateTags = []string{
+	"com.apple.quicktime.creationdate",
+	"creation_time",
+}
+
+// Container tags holding the location in ISO 6709 format
+var videoLocationTags = []string{
+	"com.apple.quicktime.location.ISO6709",
+	"location",
+	"location-eng",
+}
+
+// ParseVideoContainerExif reads the capture date and location from the container metadata of a video,
+// it returns nil if none of them are present.
+func ParseVideoContainerExif(probeData *ffprobe.ProbeData) *models.MediaEXIF {
+	tagLists := make([]ffprobe.Tags, 0)
+	if probeData.Format != nil {
+		tagLists = append(tagLists, probeData.Format.TagList)
+	}
+
+	for _, stream := range probeData.Streams {
+		tagLists = append(tagLists, stream.TagList)
+	}
+
+	newExif := models.MediaEXIF{}
+	found := false
+
+	for _, tag := range videoLocationTags {
+		if value, ok := findVideoTag(tagLists, tag); ok {
+			if latitude, longitude, err := ParseISO6709(value); err == nil {
+				newExif.GPSLatitude = &latitude
+				newExif.GPSLongitude = &longitude
+				found = true
+				break
+			}
+		}
+	}
+
+	for _, tag := range videoDateTags {
+		if value, ok := findVideoTag(tagLists, tag); ok {
+			if date, hasOffset, err := parseVideoDate(value); err == nil {
+				// Dates are stored as the local time of the place of capture, together with the offset if it is known
+				var dateShot time.Time
+				if hasOffset {
+					_, offset := date.Zone()
+					dateShot = wallClockTime(date)
+					newExif.DateShotOffset = &offset
+				} else {
+					dateShot, newExif.DateShotOffset = localTimeAt(date, newExif.GPSLatitude, newExif.GPSLongitude)
+				}
+
+				newExif.DateShot = &dateShot
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	return &newExif
+}
+
+func findVideoTag(tagLists []ffprobe.Tags, tag string) (string, bool) {
+	for _, tags := range tagLists {
+		for key := range tags {
+			if !strings.EqualFold(key, tag) {
+				continue
+			}
+
+			if value, err := tags.GetString(key); err == nil && strings.TrimSpace(value) != "" {
+				return strings.TrimSpace(value), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// parseVideoDate parses the date of a video, and tells if the date includes the offset of the place of capture.
+// Dates with a `Z` suffix or without an offset are in UTC according to the QuickTime specification,
+// which does not tell anything about the place of capture.
+func parseVideoDate(value string) (time.Time, bool, error) {
+	offsetLayouts := []string{
+		time.RFC3339Nano,
+		"2006-01-02T15:04:05-0700",
+		"2006-01-02T15:04:05.999999999-0700",
+		"2006-01-02 15:04:05-07:00",
+	}
+
+	for _, layout := range offsetLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, !strings.HasSuffix(value, "Z"), nil
+		}
+	}
+
+	utcLayouts := []string{
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02 15:04:05",
+	}
+
+	for _, layout := range utcLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, false, nil
+		}
+	}
+
+	return time.Time{}, false, errors.Errorf("unknown video date format: %s", value)
+}
+
+func wallClockTime(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), time.UTC)
+}
+
+var iso6709Regex = regexp.MustCompile(`^([+-]\d+(?:\.\d+)?)([+-]\d+(?:\.\d+)?)(?:[+-]\d+(?:\.\d+)?)?(?:CRS[^/]*)?/?$`)
+
+// ParseISO6709 parses a location such as `+37.3349-122.0090+020.000/`, as stored in the metadata of videos.
+// Coordinates given in degrees and minutes, or degrees, minutes and seconds, are supported as well.
+func ParseISO6709(value string) (latitude float64, longitude float64, err error) {
+	matches := iso6709Regex.FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return 0, 0, errors.Errorf("invalid ISO 6709 location: %s", value)
+	}
+
+	latitude, err = parseISO6709Coordinate(matches[1], 2)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	longitude, err = parseISO6709Coordinate(matches[2], 3)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if math.Abs(latitude) > 90 || math.Abs(longitude) > 180 {
+		return 0, 0, errors.Errorf("ISO 6709 location out of range: %s", value)
+	}
+
+	return latitude, longitude, nil
+}
+
+// parseISO6709Coordinate parses a signed coordinate, where the number of digits before the decimal point
+// tells if it is given as degrees (D), degrees and minutes (DDMM) or degrees, minutes and seconds (DDMMSS)
+func parseISO6709Coordinate(value string, degreeDigits int) (float64, error) {
+	sign := 1.0
+	if value[0] == '-' {
+		sign = -1.0
+	}
+	value = value[1:]
+
+	integerDigits := strings.IndexByte(value, '.')
+	if integerDigits == -1 {
+		integerDigits = len(value)
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid ISO 6709 coordinate: %s", value)
+	}
+
+	var degrees float64
+	switch integerDigits {
+	case degreeDigits + 2:
+		minutes := math.Mod(number, 100)
+		degrees = math.Floor(number/100) + minutes/60
+	case degreeDigits + 4:
+		seconds := math.Mod(number, 100)
+		minutes := math.Mod(math.Floor(number/100), 100)
+		degrees = math.Floor(number/10000) + minutes/60 + seconds/3600
+	default:
+		degrees = number
+	}
+
+	return sign * degrees, nil
+}
+
+// SaveVideoContainerExif saves the capture date and location read from the container metadata of a video.
+// The capture date replaces the date of the media, and the location is only used if the video has no location yet.
+func SaveVideoContainerExif(tx *gorm.DB, video *models.Media, probeData *ffprobe.ProbeData) error {
+	containerExif := ParseVideoContainerExif(probeData)
+	if containerExif == nil {
+		return nil
+	}
+
+	var videoExif models.MediaEXIF
+	if video.ExifID != nil {
+		if err := tx.First(&videoExif, video.ExifID).Error; err != nil {
+			return errors.Wrap(err, "get EXIF for video from database")
+		}
+	}
+
+	if containerExif.DateShot != nil {
+		videoExif.DateShot = containerExif.DateShot
+		videoExif.DateShotOffset = containerExif.DateShotOffset
+	}
+
+	if videoExif.Coordinates() == nil && containerExif.GPSLatitude != nil {
+		videoExif.GPSLatitude = containerExif.GPSLatitude
+		videoExif.GPSLongitude = containerExif.GPSLongitude
+	}
+
+	override, err := models.FindMediaOverride(tx, video.ID)
+	if err != nil {
+		return err
+	}
+
+	if override != nil {
+		override.ApplyTo(&videoExif)
+	}
+	applyPlace(&videoExif)
+
+	if err := tx.Save(&videoExif).Error; err != nil {
+		return errors.Wrap(err, "save video container metadata to database")
+	}
+
+	video.ExifID = &videoExif.ID
+	video.Exif = &videoExif
+
+	if videoExif.DateShot != nil {
+		video.DateShot = *videoExif.DateShot
+		video.DateShotOffset = videoExif.DateShotOffset
+	}
+
+	if err := tx.Model(video).Select("exif_id", "date_shot", "date_shot_offset").Updates(video).Error; err != nil {
+		return errors.Wrap(err, "update video date_shot")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package exif_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/vansante/go-ffprobe.v2"
+)
+
+func TestParseISO6709(t *testing.T) {
+	tests := []struct {
+		value     string
+		latitude  float64
+		longitude float64
+	}{
+		{"+37.3349-122.0090+020.000/", 37.3349, -122.0090},
+		{"+55.6761+012.5683/", 55.6761, 12.5683},
+		{"-33.8688+151.2093", -33.8688, 151.2093},
+		{"+4040.5-07400.0/", 40.675, -74},
+		{"+404030-0740000/", 40.675, -74},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			latitude, longitude, err := exif.ParseISO6709(test.value)
+			assert.NoError(t, err)
+			assert.InDelta(t, test.latitude, latitude, 0.0001)
+			assert.InDelta(t, test.longitude, longitude, 0.0001)
+		})
+	}
+
+	_, _, err := exif.ParseISO6709("not a location")
+	assert.Error(t, err)
+
+	_, _, err = exif.ParseISO6709("+95.0000+010.0000/")
+	assert.Error(t, err)
+}
+
+func TestParseVideoContainerExif(t *testing.T) {
+	t.Run("iPhone video", func(t *testing.T) {
+		probeData := &ffprobe.ProbeData{
+			Format: &ffprobe.Format{
+				TagList: ffprobe.Tags{
+					"creation_time":                        "2023-05-01T12:34:56.000000Z",
+					"com.apple.quicktime.creationdate":     "2023-05-01T14:34:56+0200",
+					"com.apple.quicktime.location.ISO6709": "+55.6761+012.5683+010.000/",
+				},
+			},
+		}
+
+		videoExif := exif.ParseVideoContainerExif(probeData)
+		if assert.NotNil(t, videoExif) {
+			assert.Equal(t, time.Date(2023, 5, 1, 14, 34, 56, 0, time.UTC), *videoExif.DateShot)
+			assert.Equal(t, 2*3600, *videoExif.DateShotOffset)
+			assert.InDelta(t, 55.6761, *videoExif.GPSLatitude, 0.0001)
+			assert.InDelta(t, 12.5683, *videoExif.GPSLongitude, 0.0001)
+		}
+	})
+
+	t.Run("Android video", func(t *testing.T) {
+		probeData := &ffprobe.ProbeData{
+			Format: &ffprobe.Format{
+				TagList: ffprobe.Tags{
+					"location":     "-33.8688+151.2093/",
+					"location-eng": "-33.8688+151.2093/",
+				},
+			},
+			Streams: []*ffprobe.Stream{
+				{TagList: ffprobe.Tags{"creation_time": "2023-05-01T12:34:56.000000Z"}},
+			},
+		}
+
+		videoExif := exif.ParseVideoContainerExif(probeData)
+		if assert.NotNil(t, videoExif) {
+			expected := time.Date(2023, 5, 1, 12, 34, 56, 0, time.UTC).In(time.Local)
+			assert.Equal(t, expected.Hour(), videoExif.DateShot.Hour())
+			assert.InDelta(t, -33.8688, *videoExif.GPSLatitude, 0.0001)
+		}
+	})
+
+	t.Run("No metadata", func(t *testing.T) {
+		probeData := &ffprobe.ProbeData{
+			Format: &ffprobe.Format{TagList: ffprobe.Tags{"encoder": "Lavf58.76.100"}},
+		}
+
+		assert.Nil(t, exif.ParseVideoContainerExif(probeData))
+	})
+}
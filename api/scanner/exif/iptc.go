@@ -0,0 +1,122 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// photoshopResourceIPTC is the id of the Photoshop image resource holding the IPTC-IIM records
+	photoshopResourceIPTC = 0x0404
+
+	iptcTagMarker             = 0x1C
+	iptcApplicationRecord     = 2
+	iptcKeywordsDataset       = 25
+	iptcEnvelopeRecord        = 1
+	iptcCodedCharacterDataset = 90
+)
+
+var photoshopSignature = []byte("Photoshop 3.0\x00")
+
+// ReadIPTCKeywords returns the IPTC keywords stored in the Photoshop segment of a JPEG file,
+// or nil if the file is not a JPEG or has no keywords
+func ReadIPTCKeywords(mediaPath string) ([]string, error) {
+	segment, err := readJPEGSegment(mediaPath, jpegMarkerAPP13, photoshopSignature)
+	if err != nil || segment == nil {
+		return nil, err
+	}
+
+	if records := findPhotoshopResource(segment, photoshopResourceIPTC); records != nil {
+		return parseIPTCKeywords(records), nil
+	}
+
+	return nil, nil
+}
+
+// findPhotoshopResource returns the data of the Photoshop image resource with the given id
+func findPhotoshopResource(resources []byte, id uint16) []byte {
+	for len(resources) >= 12 && bytes.HasPrefix(resources, []byte("8BIM")) {
+		resourceID := binary.BigEndian.Uint16(resources[4:6])
+
+		// The name is a pascal string padded to an even length
+		nameLength := int(resources[6]) + 1
+		nameLength += nameLength % 2
+		if 6+nameLength+4 > len(resources) {
+			return nil
+		}
+
+		sizeOffset := 6 + nameLength
+		size := int(binary.BigEndian.Uint32(resources[sizeOffset : sizeOffset+4]))
+		dataOffset := sizeOffset + 4
+		if size < 0 || dataOffset+size > len(resources) {
+			return nil
+		}
+
+		if resourceID == id {
+			return resources[dataOffset : dataOffset+size]
+		}
+
+		// The data is padded to an even length as well
+		next := dataOffset + size + size%2
+		if next > len(resources) {
+			return nil
+		}
+		resources = resources[next:]
+	}
+
+	return nil
+}
+
+// parseIPTCKeywords returns the keywords of the IPTC-IIM records. The keywords are decoded as UTF-8 if the records say so
+// or if they are valid UTF-8, otherwise they are decoded as Latin-1 which was used by older software.
+func parseIPTCKeywords(records []byte) []string {
+	keywords := make([][]byte, 0)
+	isUTF8 := false
+
+	for len(records) >= 5 && records[0] == iptcTagMarker {
+		record, dataset := records[1], records[2]
+		size := int(binary.BigEndian.Uint16(records[3:5]))
+
+		// Extended datasets larger than 32 KiB are not used for keywords
+		if size&0x8000 != 0 || 5+size > len(records) {
+			break
+		}
+		data := records[5 : 5+size]
+
+		switch {
+		case record == iptcEnvelopeRecord && dataset == iptcCodedCharacterDataset:
+			// The escape sequence ESC % G selects UTF-8
+			isUTF8 = bytes.Equal(data, []byte("\x1b%G"))
+		case record == iptcApplicationRecord && dataset == iptcKeywordsDataset:
+			keywords = append(keywords, data)
+		}
+
+		records = records[5+size:]
+	}
+
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		var text string
+		if isUTF8 || utf8.Valid(keyword) {
+			text = string(keyword)
+		} else {
+			runes := make([]rune, len(keyword))
+			for i, b := range keyword {
+				runes[i] = rune(b)
+			}
+			text = string(runes)
+		}
+
+		if text = strings.TrimSpace(text); text != "" {
+			result = append(result, text)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,91 @@
+package exif_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/stretchr/testify/assert"
+)
+
+// iptcJPEG returns a minimal JPEG file holding the IPTC records in a Photoshop segment
+func iptcJPEG(records ...[]byte) []byte {
+	iptc := bytes.Join(records, nil)
+
+	var resource bytes.Buffer
+	resource.WriteString("8BIM")
+	binary.Write(&resource, binary.BigEndian, uint16(0x0404))
+	resource.Write([]byte{0, 0})
+	binary.Write(&resource, binary.BigEndian, uint32(len(iptc)))
+	resource.Write(iptc)
+	if len(iptc)%2 == 1 {
+		resource.WriteByte(0)
+	}
+
+	segment := append([]byte("Photoshop 3.0\x00"), resource.Bytes()...)
+
+	var file bytes.Buffer
+	file.Write([]byte{0xFF, 0xD8})
+	file.Write([]byte{0xFF, 0xE0, 0x00, 0x04, 0x00, 0x00})
+	file.Write([]byte{0xFF, 0xED})
+	binary.Write(&file, binary.BigEndian, uint16(len(segment)+2))
+	file.Write(segment)
+	file.Write([]byte{0xFF, 0xDA, 0x00, 0x02, 0xFF, 0xD9})
+	return file.Bytes()
+}
+
+func iptcRecord(record byte, dataset byte, data string) []byte {
+	result := []byte{0x1C, record, dataset, 0, 0}
+	binary.BigEndian.PutUint16(result[3:], uint16(len(data)))
+	return append(result, data...)
+}
+
+func TestReadIPTCKeywords(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		content  []byte
+		keywords []string
+	}{
+		{
+			name: "utf8",
+			content: iptcJPEG(
+				iptcRecord(1, 90, "\x1b%G"),
+				iptcRecord(2, 5, "title"),
+				iptcRecord(2, 25, "Café"),
+				iptcRecord(2, 25, " holiday "),
+			),
+			keywords: []string{"Café", "holiday"},
+		},
+		{
+			name:     "latin1",
+			content:  iptcJPEG(iptcRecord(2, 25, "Caf\xe9")),
+			keywords: []string{"Café"},
+		},
+		{
+			name:     "no keywords",
+			content:  iptcJPEG(iptcRecord(2, 5, "title")),
+			keywords: nil,
+		},
+		{
+			name:     "not a jpeg",
+			content:  []byte("<x:xmpmeta></x:xmpmeta>"),
+			keywords: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mediaPath := path.Join(dir, test.name+".jpg")
+			assert.NoError(t, os.WriteFile(mediaPath, test.content, 0644))
+
+			keywords, err := exif.ReadIPTCKeywords(mediaPath)
+			assert.NoError(t, err)
+			assert.Equal(t, test.keywords, keywords)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package exif
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	jpegMarkerAPP1  = 0xE1
+	jpegMarkerAPP13 = 0xED
+	jpegMarkerSOS   = 0xDA
+
+	tiffTagExifIFD             = 0x8769
+	tiffTagOffsetTime          = 0x9010
+	tiffTagOffsetTimeOriginal  = 0x9011
+	tiffTagOffsetTimeDigitized = 0x9012
+	tiffTypeASCII              = 2
+)
+
+var exifSignature = []byte("Exif\x00\x00")
+
+// readJPEGSegment returns the data following the signature of the first segment of a JPEG file with the given marker
+// and signature, or nil if the file is not a JPEG or has no such segment
+func readJPEGSegment(mediaPath string, segmentMarker byte, signature []byte) ([]byte, error) {
+	file, err := os.Open(mediaPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open media to read metadata (%s)", mediaPath)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	var soi [2]byte
+	if _, err := io.ReadFull(reader, soi[:]); err != nil || soi != [2]byte{0xFF, 0xD8} {
+		return nil, nil
+	}
+
+	for {
+		var marker [4]byte
+		if _, err := io.ReadFull(reader, marker[:]); err != nil {
+			return nil, nil
+		}
+		if marker[0] != 0xFF || marker[1] == jpegMarkerSOS {
+			// The image data follows, which has no more metadata segments
+			return nil, nil
+		}
+
+		length := int(binary.BigEndian.Uint16(marker[2:])) - 2
+		if length < 0 {
+			return nil, nil
+		}
+
+		if marker[1] != segmentMarker {
+			if _, err := reader.Discard(length); err != nil {
+				return nil, nil
+			}
+			continue
+		}
+
+		segment := make([]byte, length)
+		if _, err := io.ReadFull(reader, segment); err != nil {
+			return nil, errors.Wrapf(err, "read metadata segment (%s)", mediaPath)
+		}
+
+		if bytes.HasPrefix(segment, signature) {
+			return segment[len(signature):], nil
+		}
+	}
+}
+
+// readEXIFTimeOffsets returns the OffsetTime tags of the EXIF segment of a JPEG file, by the id of the tag.
+// They were added in EXIF 2.31 and are not read by the internal exif parser.
+func readEXIFTimeOffsets(mediaPath string) (map[uint16]string, error) {
+	segment, err := readJPEGSegment(mediaPath, jpegMarkerAPP1, exifSignature)
+	if err != nil || segment == nil {
+		return nil, err
+	}
+
+	if len(segment) < 8 {
+		return nil, nil
+	}
+
+	var order binary.ByteOrder
+	switch string(segment[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, nil
+	}
+
+	// readIFD calls the callback with the tag, type, count and value field of each entry in the directory at the offset
+	readIFD := func(offset uint32, callback func(tag uint16, dataType uint16, count uint32, value []byte)) {
+		if int(offset)+2 > len(segment) {
+			return
+		}
+
+		entries := int(order.Uint16(segment[offset:]))
+		for i := 0; i < entries; i++ {
+			start := int(offset) + 2 + i*12
+			if start+12 > len(segment) {
+				return
+			}
+
+			entry := segment[start : start+12]
+			callback(order.Uint16(entry[0:]), order.Uint16(entry[2:]), order.Uint32(entry[4:]), entry[8:12])
+		}
+	}
+
+	var exifIFD uint32
+	readIFD(order.Uint32(segment[4:]), func(tag uint16, dataType uint16, count uint32, value []byte) {
+		if tag == tiffTagExifIFD {
+			exifIFD = order.Uint32(value)
+		}
+	})
+
+	if exifIFD == 0 {
+		return nil, nil
+	}
+
+	offsets := make(map[uint16]string)
+	readIFD(exifIFD, func(tag uint16, dataType uint16, count uint32, value []byte) {
+		if tag < tiffTagOffsetTime || tag > tiffTagOffsetTimeDigitized || dataType != tiffTypeASCII {
+			return
+		}
+
+		// Values of up to four bytes are stored in the entry itself
+		data := value[:min(count, 4)]
+		if count > 4 {
+			start := order.Uint32(value)
+			if uint64(start)+uint64(count) > uint64(len(segment)) {
+				return
+			}
+			data = segment[start : start+count]
+		}
+
+		offsets[tag] = strings.TrimRight(string(data), "\x00 ")
+	})
+
+	return offsets, nil
+}
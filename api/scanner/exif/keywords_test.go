@@ -0,0 +1,39 @@
+package exif
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagPathsFromKeywords(t *testing.T) {
+	tests := []struct {
+		name         string
+		keywords     []string
+		hierarchical []string
+		expected     [][]string
+	}{
+		{"no keywords", nil, nil, [][]string{}},
+		{"plain keywords", []string{"beach", " summer ", "", "beach"}, nil, [][]string{{"beach"}, {"summer"}}},
+		{"single level hierarchical keyword", []string{"Sunset"}, []string{"Sunset"}, [][]string{{"Sunset"}}},
+		{
+			"levels of hierarchical keywords are left out",
+			[]string{"Italy", "Places"},
+			[]string{"Places|Italy", "Travel"},
+			[][]string{{"Places", "Italy"}, {"Travel"}},
+		},
+		{
+			"parent written before its child",
+			[]string{"Places", "Italy", "Rome"},
+			[]string{"Places", "Places|Italy|Rome", "Places|Italy|Rome"},
+			[][]string{{"Places"}, {"Places", "Italy", "Rome"}},
+		},
+		{"plain keywords with separator are ignored", []string{"a|b"}, nil, [][]string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, tagPathsFromKeywords(test.keywords, test.hierarchical))
+		})
+	}
+}
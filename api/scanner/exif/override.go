@@ -0,0 +1,74 @@
+package exif
+
+import (
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/photoview/photoview/api/graphql/models"
+)
+
+// SaveMediaOverride saves the given columns of the overrides of the media, and applies the overridden values
+// to the metadata and the capture date of the media right away, so no new import of the metadata is needed
+func SaveMediaOverride(tx *gorm.DB, media *models.Media, override *models.MediaOverride, columns []string) error {
+	override.MediaID = media.ID
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "media_id"}},
+		DoUpdates: clause.AssignmentColumns(append([]string{"updated_at"}, columns...)),
+	}).Create(override).Error
+	if err != nil {
+		return errors.Wrap(err, "save media overrides in database")
+	}
+
+	var mediaExif models.MediaEXIF
+	if media.ExifID != nil {
+		if err := tx.First(&mediaExif, *media.ExifID).Error; err != nil {
+			return errors.Wrap(err, "get media metadata from database")
+		}
+	}
+
+	override.ApplyTo(&mediaExif)
+	applyPlace(&mediaExif)
+
+	// The overrides have columns that are not part of the metadata
+	exifColumns := make([]string, 0, len(columns)+len(placeColumns))
+	for _, column := range columns {
+		if column != "location_from_track" {
+			exifColumns = append(exifColumns, column)
+		}
+	}
+
+	// The place follows the coordinates
+	if override.GPSLatitude != nil {
+		exifColumns = append(exifColumns, placeColumns...)
+	}
+
+	if media.ExifID != nil {
+		if err := tx.Model(&mediaExif).Select(exifColumns).Updates(&mediaExif).Error; err != nil {
+			return errors.Wrap(err, "update media metadata")
+		}
+	} else {
+		if err := tx.Create(&mediaExif).Error; err != nil {
+			return errors.Wrap(err, "create media metadata")
+		}
+		media.ExifID = &mediaExif.ID
+	}
+	media.Exif = &mediaExif
+
+	updates := map[string]interface{}{
+		"exif_id": media.ExifID,
+	}
+	if mediaExif.DateShot != nil {
+		media.DateShot = *mediaExif.DateShot
+		media.DateShotOffset = mediaExif.DateShotOffset
+		updates["date_shot"] = media.DateShot
+		updates["date_shot_offset"] = media.DateShotOffset
+	}
+
+	if err := tx.Model(media).UpdateColumns(updates).Error; err != nil {
+		return errors.Wrap(err, "update media date_shot")
+	}
+
+	return nil
+}
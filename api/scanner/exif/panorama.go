@@ -0,0 +1,125 @@
+package exif
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gopkg.in/vansante/go-ffprobe.v2"
+	"gorm.io/gorm"
+)
+
+// ParsePanoramaXMP reads the GPano properties of the XMP packet, it returns nil if the photo is not a panorama
+func ParsePanoramaXMP(properties XMPProperties) *models.MediaPanorama {
+	projection, found := properties.Get(xmpNamespaceGPano, "ProjectionType")
+	if !found || projection == "" {
+		return nil
+	}
+
+	intProperty := func(name string) *int {
+		value, found := properties.Get(xmpNamespaceGPano, name)
+		if !found {
+			return nil
+		}
+
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return nil
+		}
+
+		return &number
+	}
+
+	return &models.MediaPanorama{
+		ProjectionType:         strings.ToLower(projection),
+		FullPanoWidth:          intProperty("FullPanoWidthPixels"),
+		FullPanoHeight:         intProperty("FullPanoHeightPixels"),
+		CroppedAreaLeft:        intProperty("CroppedAreaLeftPixels"),
+		CroppedAreaTop:         intProperty("CroppedAreaTopPixels"),
+		CroppedAreaImageWidth:  intProperty("CroppedAreaImageWidthPixels"),
+		CroppedAreaImageHeight: intProperty("CroppedAreaImageHeightPixels"),
+	}
+}
+
+// ReadPhotoPanorama reads the GPano metadata embedded in the photo, it returns nil if the photo is not a panorama
+func ReadPhotoPanorama(photoPath string) (*models.MediaPanorama, error) {
+	packet, err := ReadEmbeddedXMP(photoPath)
+	if err != nil || packet == nil {
+		return nil, err
+	}
+
+	properties, err := ParseXMPProperties(packet)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read panorama metadata (%s)", photoPath)
+	}
+
+	return ParsePanoramaXMP(properties), nil
+}
+
+// ParseVideoPanorama reads the spherical video metadata of the first video stream,
+// it returns nil if the video is not spherical
+func ParseVideoPanorama(probeData *ffprobe.ProbeData) *models.MediaPanorama {
+	stream := probeData.FirstVideoStream()
+	if stream == nil {
+		return nil
+	}
+
+	mapping, err := stream.SideDataList.GetSphericalMapping()
+	if err != nil || mapping.Projection == "" {
+		return nil
+	}
+
+	// ffprobe names the projections as in the spherical video specification
+	projection := strings.ToLower(mapping.Projection)
+	switch projection {
+	case "equirectangular", "tiled equirectangular":
+		projection = models.PanoramaEquirectangular
+	}
+
+	return &models.MediaPanorama{
+		ProjectionType: projection,
+	}
+}
+
+// SavePanorama links the panorama metadata to the media, replacing the previous metadata.
+// The previous metadata is removed when the panorama is nil, as the media is no longer a panorama.
+func SavePanorama(tx *gorm.DB, media *models.Media, panorama *models.MediaPanorama) error {
+	if panorama == nil {
+		if media.PanoramaID == nil {
+			return nil
+		}
+
+		// The loaded panorama is cleared first, otherwise it is saved and linked again by the update
+		previousID := *media.PanoramaID
+		media.PanoramaID = nil
+		media.Panorama = nil
+
+		if err := tx.Model(media).Update("panorama_id", nil).Error; err != nil {
+			return errors.Wrap(err, "unlink panorama metadata from media")
+		}
+
+		if err := tx.Delete(&models.MediaPanorama{}, previousID).Error; err != nil {
+			return errors.Wrap(err, "delete panorama metadata from database")
+		}
+
+		return nil
+	}
+
+	if media.PanoramaID != nil {
+		panorama.ID = *media.PanoramaID
+	}
+
+	if err := tx.Save(panorama).Error; err != nil {
+		return errors.Wrap(err, "save panorama metadata to database")
+	}
+
+	media.PanoramaID = &panorama.ID
+	media.Panorama = panorama
+
+	if err := tx.Model(media).Update("panorama_id", panorama.ID).Error; err != nil {
+		return errors.Wrap(err, "link panorama metadata to media")
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package exif_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/vansante/go-ffprobe.v2"
+)
+
+func TestParsePanoramaXMP(t *testing.T) {
+	attributePacket := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:GPano="http://ns.google.com/photos/1.0/panorama/"
+      GPano:ProjectionType="equirectangular"
+      GPano:FullPanoWidthPixels="8000"
+      GPano:FullPanoHeightPixels="4000"
+      GPano:CroppedAreaLeftPixels="0"
+      GPano:CroppedAreaTopPixels="500"
+      GPano:CroppedAreaImageWidthPixels="8000"
+      GPano:CroppedAreaImageHeightPixels="3000"/>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	properties, err := exif.ParseXMPProperties([]byte(attributePacket))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	panorama := exif.ParsePanoramaXMP(properties)
+	if assert.NotNil(t, panorama) {
+		assert.True(t, panorama.IsEquirectangular())
+		assert.Equal(t, 8000, *panorama.FullPanoWidth)
+		assert.Equal(t, 500, *panorama.CroppedAreaTop)
+		assert.Equal(t, 3000, *panorama.CroppedAreaImageHeight)
+	}
+
+	elementPacket := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about="" xmlns:Pano="http://ns.google.com/photos/1.0/panorama/">
+      <Pano:ProjectionType>cylindrical</Pano:ProjectionType>
+      <Pano:FullPanoWidthPixels>6000</Pano:FullPanoWidthPixels>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	properties, err = exif.ParseXMPProperties([]byte(elementPacket))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	panorama = exif.ParsePanoramaXMP(properties)
+	if assert.NotNil(t, panorama) {
+		assert.Equal(t, "cylindrical", panorama.ProjectionType)
+		assert.Equal(t, 6000, *panorama.FullPanoWidth)
+		assert.Nil(t, panorama.FullPanoHeight)
+	}
+
+	properties, err = exif.ParseXMPProperties([]byte(`<x:xmpmeta xmlns:x="adobe:ns:meta/"></x:xmpmeta>`))
+	assert.NoError(t, err)
+	assert.Nil(t, exif.ParsePanoramaXMP(properties))
+}
+
+func TestParseVideoPanorama(t *testing.T) {
+	var probeData ffprobe.ProbeData
+	err := json.Unmarshal([]byte(`{"streams": [{"codec_type": "video", "side_data_list": [
+		{"side_data_type": "Spherical Mapping", "projection": "equirectangular"}
+	]}]}`), &probeData)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	panorama := exif.ParseVideoPanorama(&probeData)
+	if assert.NotNil(t, panorama) {
+		assert.True(t, panorama.IsEquirectangular())
+	}
+
+	assert.Nil(t, exif.ParseVideoPanorama(&ffprobe.ProbeData{}))
+}
+
+func TestSavePanorama(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	album := models.Album{Title: "album", Path: "/photos"}
+	assert.NoError(t, db.Save(&album).Error)
+
+	media := models.Media{Title: "pano.jpg", Path: "/photos/pano.jpg", AlbumID: album.ID}
+	assert.NoError(t, db.Save(&media).Error)
+
+	assert.NoError(t, exif.SavePanorama(db, &media, &models.MediaPanorama{ProjectionType: "equirectangular"}))
+	if !assert.NotNil(t, media.PanoramaID) {
+		return
+	}
+	panoramaID := *media.PanoramaID
+
+	assert.NoError(t, exif.SavePanorama(db, &media, nil))
+	assert.Nil(t, media.PanoramaID)
+
+	var saved models.Media
+	assert.NoError(t, db.First(&saved, media.ID).Error)
+	assert.Nil(t, saved.PanoramaID, "the media is no longer linked to the panorama metadata")
+
+	var count int64
+	assert.NoError(t, db.Model(&models.MediaPanorama{}).Where("id = ?", panoramaID).Count(&count).Error)
+	assert.Equal(t, int64(0), count, "the panorama metadata is removed")
+}
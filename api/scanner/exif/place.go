@@ -0,0 +1,70 @@
+package exif
+
+import (
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/geocode"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// placeColumns are the columns of the place found from the coordinates of the media
+var placeColumns = []string{"country", "region", "city"}
+
+// applyPlace sets the country, region and city of the metadata from its coordinates,
+// they are removed if the place is unknown
+func applyPlace(exif *models.MediaEXIF) {
+	exif.Country, exif.Region, exif.City = nil, nil, nil
+
+	coordinates := exif.Coordinates()
+	if coordinates == nil {
+		return
+	}
+
+	setPlace(exif, geocode.ReverseGeocode(coordinates.Latitude, coordinates.Longitude))
+}
+
+func setPlace(exif *models.MediaEXIF, place *geocode.Place) {
+	if place == nil {
+		return
+	}
+
+	exif.Country = emptyStringToNil(place.Country)
+	exif.Region = emptyStringToNil(place.Region)
+	exif.City = emptyStringToNil(place.City)
+}
+
+// AddMissingPlaces finds the places of the media with coordinates but no place, such as media scanned before
+// the cities were available. It returns the number of media that were given a place.
+func AddMissingPlaces(db *gorm.DB, cities *geocode.Cities) (int, error) {
+	added := 0
+
+	var batch []*models.MediaEXIF
+	err := db.Where("gps_latitude IS NOT NULL AND gps_longitude IS NOT NULL AND country IS NULL").
+		FindInBatches(&batch, 100, func(tx *gorm.DB, _ int) error {
+			for _, exif := range batch {
+				place := cities.Lookup(*exif.GPSLatitude, *exif.GPSLongitude)
+				if place == nil {
+					continue
+				}
+
+				setPlace(exif, place)
+				if err := tx.Model(exif).Select(placeColumns).Updates(exif).Error; err != nil {
+					return errors.Wrapf(err, "save place of media exif (%d)", exif.ID)
+				}
+				added++
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return added, errors.Wrap(err, "add missing places")
+	}
+
+	return added, nil
+}
+
+func emptyStringToNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,54 @@
+package exif_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/scanner/geocode"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddMissingPlaces(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	citiesPath := path.Join(t.TempDir(), "cities15000.txt")
+	line := "1\tLisbon\tLisbon\t\t38.71667\t-9.13333\tP\tPPLC\tPT\t\t14"
+	assert.NoError(t, os.WriteFile(citiesPath, []byte(line), 0644))
+
+	cities, err := geocode.LoadCities(citiesPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	latitude, longitude := 38.7223, -9.1393
+	farLatitude, farLongitude := -33.87, 151.21
+	country := "Somewhere"
+
+	withoutPlace := models.MediaEXIF{GPSLatitude: &latitude, GPSLongitude: &longitude}
+	farAway := models.MediaEXIF{GPSLatitude: &farLatitude, GPSLongitude: &farLongitude}
+	withPlace := models.MediaEXIF{GPSLatitude: &latitude, GPSLongitude: &longitude, Country: &country}
+	withoutCoordinates := models.MediaEXIF{}
+	for _, mediaExif := range []*models.MediaEXIF{&withoutPlace, &farAway, &withPlace, &withoutCoordinates} {
+		assert.NoError(t, db.Save(mediaExif).Error)
+	}
+
+	added, err := exif.AddMissingPlaces(db, cities)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, added)
+
+	assert.NoError(t, db.First(&withoutPlace, withoutPlace.ID).Error)
+	if assert.NotNil(t, withoutPlace.Country) && assert.NotNil(t, withoutPlace.City) {
+		assert.Equal(t, "PT", *withoutPlace.Country)
+		assert.Equal(t, "Lisbon", *withoutPlace.City)
+	}
+
+	assert.NoError(t, db.First(&farAway, farAway.ID).Error)
+	assert.Nil(t, farAway.Country)
+
+	assert.NoError(t, db.First(&withPlace, withPlace.ID).Error)
+	assert.Equal(t, "Somewhere", *withPlace.Country, "places already found are kept")
+}
@@ -0,0 +1,189 @@
+package exif
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	xmlNamespace      = "http://www.w3.org/XML/1998/namespace"
+	xmpNamespaceRDF   = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmpNamespaceGPano = "http://ns.google.com/photos/1.0/panorama/"
+	xmpNamespaceMM    = "http://ns.adobe.com/xap/1.0/mm/"
+	xmpNamespaceStRef = "http://ns.adobe.com/xap/1.0/sType/ResourceRef#"
+)
+
+// xmpSearchLimit is how much of a media file is searched for an embedded XMP packet,
+// which is stored in the header of JPEG files and near the start of most other formats
+const xmpSearchLimit = 1 << 20
+
+// XMPProperties holds the properties of an XMP packet by namespace and name.
+// Fields of structures are stored as `property/field` and items of arrays as `property[index]`, starting from 1.
+type XMPProperties map[string]string
+
+func (properties XMPProperties) Get(namespace string, name string) (string, bool) {
+	value, found := properties[namespace+name]
+	return value, found
+}
+
+// GetField returns a field of a structure property, such as the document id of `xmpMM:DerivedFrom`
+func (properties XMPProperties) GetField(namespace string, name string, fieldNamespace string, fieldName string) (string, bool) {
+	value, found := properties[namespace+name+"/"+fieldNamespace+fieldName]
+	return value, found
+}
+
+// GetArray returns the items of an array property, in the order they were written
+func (properties XMPProperties) GetArray(namespace string, name string) []string {
+	items := make([]string, 0)
+	for i := 1; ; i++ {
+		item, found := properties[fmt.Sprintf("%s%s[%d]", namespace, name, i)]
+		if !found {
+			return items
+		}
+		items = append(items, item)
+	}
+}
+
+// ReadEmbeddedXMP returns the XMP packet embedded in the media file, or nil if none was found
+func ReadEmbeddedXMP(mediaPath string) ([]byte, error) {
+	file, err := os.Open(mediaPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open media to read XMP (%s)", mediaPath)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, xmpSearchLimit))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read media to find XMP (%s)", mediaPath)
+	}
+
+	for _, element := range []string{"x:xmpmeta", "rdf:RDF"} {
+		start := bytes.Index(data, []byte("<"+element))
+		if start == -1 {
+			continue
+		}
+
+		endTag := []byte("</" + element + ">")
+		end := bytes.Index(data[start:], endTag)
+		if end == -1 {
+			continue
+		}
+
+		return data[start : start+end+len(endTag)], nil
+	}
+
+	return nil, nil
+}
+
+// xmpElement is an element of the XMP packet being parsed
+type xmpElement struct {
+	// key is the property path of the element, empty outside of properties
+	key      string
+	value    strings.Builder
+	children int
+	isItem   bool
+	// items counts the items of an array element
+	items int
+}
+
+// ParseXMPProperties reads the properties of the XMP packet, which are written either as attributes
+// or as child elements of `rdf:Description`, with structures and arrays nested inside them
+func ParseXMPProperties(packet []byte) (XMPProperties, error) {
+	properties := make(XMPProperties)
+	decoder := xml.NewDecoder(bytes.NewReader(packet))
+
+	stack := make([]*xmpElement, 0)
+	// The number of rdf:Description elements outside of properties that are open
+	descriptions := 0
+
+	storeAttributes := func(prefix string, attrs []xml.Attr) {
+		for _, attr := range attrs {
+			if attr.Name.Space == "xmlns" || attr.Name.Space == xmpNamespaceRDF || attr.Name.Space == "" || attr.Name.Space == xmlNamespace {
+				continue
+			}
+			properties[prefix+attr.Name.Space+attr.Name.Local] = strings.TrimSpace(attr.Value)
+		}
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "parse XMP")
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			var parent *xmpElement
+			if len(stack) > 0 {
+				parent = stack[len(stack)-1]
+				parent.children++
+			}
+
+			element := &xmpElement{}
+			isRDF := token.Name.Space == xmpNamespaceRDF
+
+			switch {
+			case parent == nil || parent.key == "":
+				if isRDF && token.Name.Local == "Description" {
+					// Top level description, its attributes are simple properties
+					descriptions++
+					storeAttributes("", token.Attr)
+				} else if descriptions > 0 && !isRDF {
+					element.key = token.Name.Space + token.Name.Local
+					storeAttributes(element.key+"/", token.Attr)
+				}
+			case isRDF && token.Name.Local == "li":
+				parent.items++
+				element.key = fmt.Sprintf("%s[%d]", parent.key, parent.items)
+				element.isItem = true
+				storeAttributes(element.key+"/", token.Attr)
+			case isRDF:
+				// rdf:Description of a structure, or rdf:Bag, rdf:Seq and rdf:Alt of an array
+				element.key = parent.key
+				if token.Name.Local == "Description" {
+					storeAttributes(element.key+"/", token.Attr)
+				}
+			default:
+				element.key = parent.key + "/" + token.Name.Space + token.Name.Local
+				storeAttributes(element.key+"/", token.Attr)
+			}
+
+			stack = append(stack, element)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].value.Write(token)
+			}
+		case xml.EndElement:
+			if len(stack) == 0 {
+				continue
+			}
+
+			element := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if element.key == "" {
+				if token.Name.Space == xmpNamespaceRDF && token.Name.Local == "Description" && descriptions > 0 {
+					descriptions--
+				}
+				continue
+			}
+
+			if element.children == 0 {
+				if value := strings.TrimSpace(element.value.String()); value != "" || element.isItem {
+					properties[element.key] = value
+				}
+			}
+		}
+	}
+
+	return properties, nil
+}
@@ -0,0 +1,251 @@
+package exif
+
+import (
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	xmpNamespaceXMP       = "http://ns.adobe.com/xap/1.0/"
+	xmpNamespaceDC        = "http://purl.org/dc/elements/1.1/"
+	xmpNamespaceEXIF      = "http://ns.adobe.com/exif/1.0/"
+	xmpNamespaceDigiKam   = "http://www.digikam.org/ns/1.0/"
+	xmpNamespaceDarktable = "http://darktable.sf.net/"
+	xmpNamespaceLightroom = "http://ns.adobe.com/lightroom/1.0/"
+)
+
+// digiKamColorLabels are the colours of the `digiKam:ColorLabel` numbers, starting from 1
+var digiKamColorLabels = []string{"red", "orange", "yellow", "green", "blue", "magenta", "gray", "black", "white"}
+
+// darktableColorLabels are the colours of the `darktable:colorlabels` numbers, starting from 0
+var darktableColorLabels = []string{"red", "yellow", "green", "blue", "purple"}
+
+// XMPMetadata is the descriptive metadata of an XMP packet, as written by Lightroom, darktable and digiKam
+type XMPMetadata struct {
+	Title       *string
+	Description *string
+	Rating      *int64
+	ColorLabel  *string
+	Keywords    []string
+	// HierarchicalKeywords are the keywords with their parents, separated by `|`
+	HierarchicalKeywords []string
+	GPSLatitude          *float64
+	GPSLongitude         *float64
+}
+
+// ParseXMPMetadata reads the descriptive metadata of the XMP properties, it returns nil if none was found
+func ParseXMPMetadata(properties XMPProperties) *XMPMetadata {
+	metadata := XMPMetadata{
+		Title:       languageAlternative(properties, xmpNamespaceDC, "title"),
+		Description: languageAlternative(properties, xmpNamespaceDC, "description"),
+		Keywords:    properties.GetArray(xmpNamespaceDC, "subject"),
+
+		HierarchicalKeywords: properties.GetArray(xmpNamespaceLightroom, "hierarchicalSubject"),
+	}
+
+	if value, found := properties.Get(xmpNamespaceXMP, "Rating"); found {
+		if rating, err := strconv.ParseFloat(value, 64); err == nil {
+			stars := int64(math.Max(-1, math.Min(5, math.Round(rating))))
+			metadata.Rating = &stars
+		}
+	}
+
+	if value, found := properties.Get(xmpNamespaceXMP, "Label"); found && value != "" {
+		label := normalizeColorLabel(value)
+		metadata.ColorLabel = &label
+	} else if value, found := properties.Get(xmpNamespaceDigiKam, "ColorLabel"); found {
+		if index, err := strconv.Atoi(value); err == nil && index >= 1 && index <= len(digiKamColorLabels) {
+			label := digiKamColorLabels[index-1]
+			metadata.ColorLabel = &label
+		}
+	} else if labels := properties.GetArray(xmpNamespaceDarktable, "colorlabels"); len(labels) > 0 {
+		if index, err := strconv.Atoi(labels[0]); err == nil && index >= 0 && index < len(darktableColorLabels) {
+			label := darktableColorLabels[index]
+			metadata.ColorLabel = &label
+		}
+	}
+
+	latitude, latitudeFound := properties.Get(xmpNamespaceEXIF, "GPSLatitude")
+	longitude, longitudeFound := properties.Get(xmpNamespaceEXIF, "GPSLongitude")
+	if latitudeFound && longitudeFound {
+		lat, latErr := parseXMPCoordinate(latitude)
+		long, longErr := parseXMPCoordinate(longitude)
+		if latErr == nil && longErr == nil && math.Abs(lat) <= 90 && math.Abs(long) <= 180 {
+			metadata.GPSLatitude = &lat
+			metadata.GPSLongitude = &long
+		}
+	}
+
+	if metadata.Title == nil && metadata.Description == nil && metadata.Rating == nil && metadata.ColorLabel == nil &&
+		len(metadata.Keywords) == 0 && len(metadata.HierarchicalKeywords) == 0 && metadata.GPSLatitude == nil {
+		return nil
+	}
+
+	return &metadata
+}
+
+// ReadXMPMetadata reads the XMP metadata embedded in the media file, with the metadata of the sidecar file merged over it.
+// It returns nil if neither has any descriptive metadata.
+func ReadXMPMetadata(mediaPath string, sidecarPath *string) (*XMPMetadata, error) {
+	var metadata *XMPMetadata
+
+	packet, err := ReadEmbeddedXMP(mediaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if packet != nil {
+		properties, err := ParseXMPProperties(packet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read embedded XMP metadata (%s)", mediaPath)
+		}
+		metadata = ParseXMPMetadata(properties)
+	}
+
+	if sidecarPath == nil {
+		return metadata, nil
+	}
+
+	sidecar, err := os.ReadFile(*sidecarPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read XMP sidecar (%s)", *sidecarPath)
+	}
+
+	properties, err := ParseXMPProperties(sidecar)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read XMP sidecar metadata (%s)", *sidecarPath)
+	}
+
+	sidecarMetadata := ParseXMPMetadata(properties)
+	if metadata == nil {
+		return sidecarMetadata, nil
+	}
+
+	metadata.Merge(sidecarMetadata)
+	return metadata, nil
+}
+
+// Merge overrides the values of the metadata with the values present in the other metadata
+func (metadata *XMPMetadata) Merge(other *XMPMetadata) {
+	if other == nil {
+		return
+	}
+
+	if other.Title != nil {
+		metadata.Title = other.Title
+	}
+	if other.Description != nil {
+		metadata.Description = other.Description
+	}
+	if other.Rating != nil {
+		metadata.Rating = other.Rating
+	}
+	if other.ColorLabel != nil {
+		metadata.ColorLabel = other.ColorLabel
+	}
+	if len(other.Keywords) > 0 {
+		metadata.Keywords = other.Keywords
+	}
+	if len(other.HierarchicalKeywords) > 0 {
+		metadata.HierarchicalKeywords = other.HierarchicalKeywords
+	}
+	if other.GPSLatitude != nil && other.GPSLongitude != nil {
+		metadata.GPSLatitude = other.GPSLatitude
+		metadata.GPSLongitude = other.GPSLongitude
+	}
+}
+
+// ApplyTo overrides the parsed EXIF values with the values present in the XMP metadata
+func (metadata *XMPMetadata) ApplyTo(exif *models.MediaEXIF) {
+	if metadata.Title != nil {
+		exif.Title = metadata.Title
+	}
+	if metadata.Description != nil {
+		exif.Description = metadata.Description
+	}
+	if metadata.Rating != nil {
+		exif.Rating = metadata.Rating
+	}
+	if metadata.ColorLabel != nil {
+		exif.ColorLabel = metadata.ColorLabel
+	}
+	if len(metadata.Keywords) > 0 {
+		exif.Keywords = metadata.Keywords
+	}
+	if len(metadata.HierarchicalKeywords) > 0 {
+		exif.HierarchicalKeywords = metadata.HierarchicalKeywords
+	}
+	if metadata.GPSLatitude != nil && metadata.GPSLongitude != nil {
+		exif.GPSLatitude = metadata.GPSLatitude
+		exif.GPSLongitude = metadata.GPSLongitude
+	}
+}
+
+// languageAlternative returns the first value of a property that may be written in several languages
+func languageAlternative(properties XMPProperties, namespace string, name string) *string {
+	value, found := properties.Get(namespace, name)
+	if !found {
+		if items := properties.GetArray(namespace, name); len(items) > 0 {
+			value = items[0]
+		}
+	}
+
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func normalizeColorLabel(label string) string {
+	label = strings.TrimSpace(label)
+	lower := strings.ToLower(label)
+
+	for _, colors := range [][]string{digiKamColorLabels, darktableColorLabels} {
+		for _, color := range colors {
+			if lower == color {
+				return color
+			}
+		}
+	}
+
+	return label
+}
+
+// parseXMPCoordinate parses a GPS coordinate written as `DDD,MM,SSk` or `DDD,MM.mmk`,
+// where k is the direction N, S, E or W, or as decimal degrees
+func parseXMPCoordinate(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, errors.New("empty coordinate")
+	}
+
+	sign := 1.0
+	switch value[len(value)-1] {
+	case 'S', 's', 'W', 'w':
+		sign = -1
+		value = value[:len(value)-1]
+	case 'N', 'n', 'E', 'e':
+		value = value[:len(value)-1]
+	}
+
+	coordinate := 0.0
+	for i, part := range strings.Split(value, ",") {
+		if i > 2 {
+			return 0, errors.Errorf("invalid coordinate (%s)", value)
+		}
+
+		number, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid coordinate (%s)", value)
+		}
+
+		coordinate += number / math.Pow(60, float64(i))
+	}
+
+	return sign * coordinate, nil
+}
@@ -0,0 +1,119 @@
+package exif_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseXMPProperties(t *testing.T) {
+	packet := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+      xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/"
+      xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#"
+      xmlns:dc="http://purl.org/dc/elements/1.1/"
+      xmpMM:DocumentID="xmp.did:edited">
+      <xmpMM:OriginalDocumentID>xmp.did:original</xmpMM:OriginalDocumentID>
+      <xmpMM:DerivedFrom rdf:parseType="Resource">
+        <stRef:documentID>xmp.did:original</stRef:documentID>
+        <stRef:instanceID>xmp.iid:original</stRef:instanceID>
+      </xmpMM:DerivedFrom>
+      <dc:subject>
+        <rdf:Bag>
+          <rdf:li>beach</rdf:li>
+          <rdf:li>summer</rdf:li>
+        </rdf:Bag>
+      </dc:subject>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	properties, err := exif.ParseXMPProperties([]byte(packet))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	instanceID, found := properties.GetField("http://ns.adobe.com/xap/1.0/mm/", "DerivedFrom", "http://ns.adobe.com/xap/1.0/sType/ResourceRef#", "instanceID")
+	assert.True(t, found)
+	assert.Equal(t, "xmp.iid:original", instanceID)
+
+	assert.Equal(t, []string{"beach", "summer"}, properties.GetArray("http://purl.org/dc/elements/1.1/", "subject"))
+
+	documentID, derivedFrom := exif.ParseDocumentIDs(properties)
+	if assert.NotNil(t, documentID) && assert.NotNil(t, derivedFrom) {
+		assert.Equal(t, "xmp.did:edited", *documentID)
+		assert.Equal(t, "xmp.did:original", *derivedFrom)
+	}
+
+	// The attribute form of a structure, without an edit history
+	properties, err = exif.ParseXMPProperties([]byte(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description xmlns:xmpMM="http://ns.adobe.com/xap/1.0/mm/" xmlns:stRef="http://ns.adobe.com/xap/1.0/sType/ResourceRef#">
+    <xmpMM:DerivedFrom stRef:documentID="xmp.did:first"/>
+  </rdf:Description>
+</rdf:RDF>`))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	documentID, derivedFrom = exif.ParseDocumentIDs(properties)
+	assert.Nil(t, documentID)
+	if assert.NotNil(t, derivedFrom) {
+		assert.Equal(t, "xmp.did:first", *derivedFrom)
+	}
+}
+
+func TestReadXMPMetadata(t *testing.T) {
+	embedded := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:xmp="http://ns.adobe.com/xap/1.0/" xmp:Rating="2">
+      <dc:title><rdf:Alt><rdf:li xml:lang="x-default">From the camera</rdf:li></rdf:Alt></dc:title>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	sidecar := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+      xmlns:dc="http://purl.org/dc/elements/1.1/"
+      xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+      xmlns:exif="http://ns.adobe.com/exif/1.0/"
+      xmp:Rating="4"
+      xmp:Label="Red"
+      exif:GPSLatitude="55,40.5N"
+      exif:GPSLongitude="12,34,30W">
+      <dc:description><rdf:Alt><rdf:li xml:lang="x-default">Harbour at dusk</rdf:li></rdf:Alt></dc:description>
+      <dc:subject><rdf:Bag><rdf:li>harbour</rdf:li><rdf:li>boats</rdf:li></rdf:Bag></dc:subject>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	tempDir := t.TempDir()
+	mediaPath := path.Join(tempDir, "photo.jpg")
+	sidecarPath := path.Join(tempDir, "photo.xmp")
+	assert.NoError(t, os.WriteFile(mediaPath, []byte("\xFF\xD8"+embedded), 0644))
+	assert.NoError(t, os.WriteFile(sidecarPath, []byte(sidecar), 0644))
+
+	metadata, err := exif.ReadXMPMetadata(mediaPath, &sidecarPath)
+	if !assert.NoError(t, err) || !assert.NotNil(t, metadata) {
+		return
+	}
+
+	assert.Equal(t, "From the camera", *metadata.Title)
+	assert.Equal(t, "Harbour at dusk", *metadata.Description)
+	assert.EqualValues(t, 4, *metadata.Rating)
+	assert.Equal(t, "red", *metadata.ColorLabel)
+	assert.Equal(t, []string{"harbour", "boats"}, metadata.Keywords)
+	assert.InDelta(t, 55.675, *metadata.GPSLatitude, 0.0001)
+	assert.InDelta(t, -12.575, *metadata.GPSLongitude, 0.0001)
+
+	description := "From EXIF"
+	parsedExif := models.MediaEXIF{Description: &description}
+	metadata.ApplyTo(&parsedExif)
+	assert.Equal(t, "Harbour at dusk", *parsedExif.Description)
+	assert.Equal(t, models.StringList{"harbour", "boats"}, parsedExif.Keywords)
+}
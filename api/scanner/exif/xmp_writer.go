@@ -0,0 +1,476 @@
+package exif
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/scanner_utils"
+	"github.com/pkg/errors"
+)
+
+// ErrXMPSidecarConflict is returned when the sidecar was changed outside of Photoview since it was last imported
+var ErrXMPSidecarConflict = errors.New("the XMP sidecar was modified outside of Photoview, scan the album to import the changes before editing the metadata")
+
+// emptyXMPSidecar is the packet new sidecars are created from
+const emptyXMPSidecar = `<?xml version="1.0" encoding="UTF-8"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/" x:xmptk="Photoview">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description rdf:about=""/>
+ </rdf:RDF>
+</x:xmpmeta>
+`
+
+// XMPSidecarUpdate holds the properties to write to an XMP sidecar.
+// Properties left as nil are kept as they are, while empty values remove the property from the sidecar.
+type XMPSidecarUpdate struct {
+	Title       *string
+	Description *string
+	Rating      *int64
+	// ColorLabel is written to `xmp:Label`
+	ColorLabel *string
+	Keywords   *[]string
+	// HierarchicalKeywords are written to `lr:hierarchicalSubject`, with the levels separated by `|`
+	HierarchicalKeywords *[]string
+}
+
+// xmpProperty is the name of a property written by the sidecar writer
+type xmpProperty struct {
+	namespace string
+	name      string
+	prefix    string
+}
+
+var (
+	xmpPropertyTitle               = xmpProperty{xmpNamespaceDC, "title", "dc"}
+	xmpPropertyDescription         = xmpProperty{xmpNamespaceDC, "description", "dc"}
+	xmpPropertySubject             = xmpProperty{xmpNamespaceDC, "subject", "dc"}
+	xmpPropertyRating              = xmpProperty{xmpNamespaceXMP, "Rating", "xmp"}
+	xmpPropertyLabel               = xmpProperty{xmpNamespaceXMP, "Label", "xmp"}
+	xmpPropertyHierarchicalSubject = xmpProperty{xmpNamespaceLightroom, "hierarchicalSubject", "lr"}
+)
+
+// FindXMPSidecar returns the path of the XMP sidecar of the media, named either `file.ext.xmp` or `file.xmp`,
+// or nil if the media has none
+func FindXMPSidecar(mediaPath string) *string {
+	pathWithoutExt := strings.TrimSuffix(mediaPath, path.Ext(mediaPath))
+
+	for _, testPath := range []string{mediaPath + ".xmp", mediaPath + ".XMP", pathWithoutExt + ".xmp", pathWithoutExt + ".XMP"} {
+		if scanner_utils.FileExists(testPath) {
+			return &testPath
+		}
+	}
+
+	return nil
+}
+
+// HashXMPSidecar returns the hash stored in `Media.SideCarHash` for the content of a sidecar
+func HashXMPSidecar(content []byte) string {
+	hash := md5.Sum(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// WriteXMPSidecar creates or updates the XMP sidecar of the media, the media file itself is never modified.
+// New sidecars are named `file.ext.xmp`, and the content of existing sidecars that is not part of the update is kept.
+//
+// If the sidecar does not match the `SideCarHash` of the media, it was changed since it was last imported
+// and ErrXMPSidecarConflict is returned without writing anything.
+// The path and hash of the written sidecar are returned, they should be saved to the media,
+// so the scanner does not import the sidecar again.
+func WriteXMPSidecar(media *models.Media, update XMPSidecarUpdate) (sidecarPath string, sidecarHash string, err error) {
+	packet := []byte(emptyXMPSidecar)
+	sidecarPath = media.Path + ".xmp"
+
+	if existingPath := FindXMPSidecar(media.Path); existingPath != nil {
+		existing, err := os.ReadFile(*existingPath)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "read XMP sidecar (%s)", *existingPath)
+		}
+
+		if media.SideCarHash == nil || *media.SideCarHash != HashXMPSidecar(existing) {
+			return "", "", ErrXMPSidecarConflict
+		}
+
+		packet = existing
+		sidecarPath = *existingPath
+	}
+
+	updated, err := UpdateXMPPacket(packet, update)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "update XMP sidecar (%s)", sidecarPath)
+	}
+
+	// Write to a temporary file first, so a failed write never leaves a truncated sidecar behind
+	tempFile, err := os.CreateTemp(path.Dir(sidecarPath), ".photoview-*.xmp")
+	if err != nil {
+		return "", "", errors.Wrapf(err, "create XMP sidecar (%s)", sidecarPath)
+	}
+	defer os.Remove(tempFile.Name())
+
+	if _, err := tempFile.Write(updated); err != nil {
+		tempFile.Close()
+		return "", "", errors.Wrapf(err, "write XMP sidecar (%s)", sidecarPath)
+	}
+	if err := tempFile.Close(); err != nil {
+		return "", "", errors.Wrapf(err, "write XMP sidecar (%s)", sidecarPath)
+	}
+	if err := os.Chmod(tempFile.Name(), 0644); err != nil {
+		return "", "", errors.Wrapf(err, "write XMP sidecar (%s)", sidecarPath)
+	}
+	if err := os.Rename(tempFile.Name(), sidecarPath); err != nil {
+		return "", "", errors.Wrapf(err, "replace XMP sidecar (%s)", sidecarPath)
+	}
+
+	return sidecarPath, HashXMPSidecar(updated), nil
+}
+
+// xmpEdit replaces the bytes of a packet from start to end
+type xmpEdit struct {
+	start       int64
+	end         int64
+	replacement string
+}
+
+// xmpOpenElement is an element of the packet being updated, with the namespace prefixes it declares
+type xmpOpenElement struct {
+	prefixes map[string]string
+	// isDescription is set for rdf:Description elements outside of properties
+	isDescription bool
+}
+
+// UpdateXMPPacket sets the properties of the update in an XMP packet.
+// The packet is edited in place, so everything but the updated properties is kept exactly as it was written.
+func UpdateXMPPacket(packet []byte, update XMPSidecarUpdate) ([]byte, error) {
+	properties := update.properties()
+	if len(properties) == 0 {
+		return packet, nil
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(packet))
+	edits := make([]xmpEdit, 0)
+
+	stack := make([]xmpOpenElement, 0)
+	// descriptionDepth is the depth of the open rdf:Description outside of properties, or -1
+	descriptionDepth := -1
+	// propertyStart is the offset of the updated property being removed, or -1
+	propertyStart := int64(-1)
+
+	// The first description is where the properties are written to
+	foundDescription := false
+	var firstDescriptionStart, firstDescriptionEnd int64
+	var firstDescriptionPrefixes []xmpOpenElement
+	insertOffset := int64(-1)
+
+	for {
+		start := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "parse XMP")
+		}
+		end := decoder.InputOffset()
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			element := xmpOpenElement{prefixes: make(map[string]string)}
+			for _, attr := range token.Attr {
+				if attr.Name.Space == "xmlns" {
+					element.prefixes[attr.Name.Local] = attr.Value
+				} else if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					element.prefixes[""] = attr.Value
+				}
+			}
+			stack = append(stack, element)
+			depth := len(stack) - 1
+
+			switch {
+			case descriptionDepth == -1 && token.Name.Space == xmpNamespaceRDF && token.Name.Local == "Description":
+				stack[depth].isDescription = true
+				descriptionDepth = depth
+
+				tag := string(packet[start:end])
+				if removed := removeXMPAttributes(tag, token.Attr, properties, stack); removed != tag {
+					edits = append(edits, xmpEdit{start, end, removed})
+				}
+
+				if !foundDescription {
+					foundDescription = true
+					firstDescriptionStart, firstDescriptionEnd = start, end
+					firstDescriptionPrefixes = append([]xmpOpenElement{}, stack...)
+				}
+			case descriptionDepth != -1 && depth == descriptionDepth+1 && propertyStart == -1:
+				for _, property := range properties {
+					if token.Name.Space == property.namespace && token.Name.Local == property.name {
+						propertyStart = start
+					}
+				}
+			}
+		case xml.EndElement:
+			if len(stack) == 0 {
+				continue
+			}
+			depth := len(stack) - 1
+
+			if depth == descriptionDepth+1 && propertyStart != -1 {
+				edits = append(edits, xmpEdit{trimLeadingWhitespace(packet, propertyStart), end, ""})
+				propertyStart = -1
+			}
+
+			if stack[depth].isDescription {
+				descriptionDepth = -1
+				if insertOffset == -1 && foundDescription {
+					insertOffset = start
+				}
+			}
+
+			stack = stack[:depth]
+		}
+	}
+
+	if !foundDescription {
+		return nil, errors.New("XMP packet has no rdf:Description element")
+	}
+
+	// Declare the namespaces of the written properties on the description, if they are not declared already
+	prefixOf := make(map[string]string)
+	declarations := ""
+	for _, property := range append([]xmpProperty{{namespace: xmpNamespaceRDF, prefix: "rdf"}}, properties...) {
+		if _, found := prefixOf[property.namespace]; found {
+			continue
+		}
+
+		if prefix, found := lookupXMPPrefix(firstDescriptionPrefixes, property.namespace); found {
+			prefixOf[property.namespace] = prefix
+			continue
+		}
+
+		prefix := property.prefix
+		for i := 1; isXMPPrefixBound(firstDescriptionPrefixes, prefix); i++ {
+			prefix = property.prefix + strconv.Itoa(i)
+		}
+		prefixOf[property.namespace] = prefix
+		declarations += fmt.Sprintf(" xmlns:%s=%q", prefix, property.namespace)
+	}
+
+	tag := string(packet[firstDescriptionStart:firstDescriptionEnd])
+	for _, edit := range edits {
+		if edit.start == firstDescriptionStart {
+			tag = edit.replacement
+		}
+	}
+
+	selfClosing := strings.HasSuffix(tag, "/>")
+	if selfClosing {
+		tag = strings.TrimSuffix(tag, "/>")
+	} else {
+		tag = strings.TrimSuffix(tag, ">")
+	}
+	tag = strings.TrimRight(tag, " \t\r\n") + declarations + ">"
+
+	indent := lineIndentation(packet, firstDescriptionStart)
+	written := writeXMPProperties(update, prefixOf, indent+" ")
+
+	edits = removeXMPEdit(edits, firstDescriptionStart)
+	if selfClosing {
+		// Close the description with the same name it was opened with
+		tagName := strings.FieldsFunc(tag[1:], func(r rune) bool { return r == ' ' || r == '\t' || r == '\r' || r == '\n' || r == '>' })[0]
+		edits = append(edits, xmpEdit{firstDescriptionStart, firstDescriptionEnd, tag + written + "\n" + indent + "</" + tagName + ">"})
+	} else {
+		edits = append(edits, xmpEdit{firstDescriptionStart, firstDescriptionEnd, tag})
+		edits = append(edits, xmpEdit{trimLeadingWhitespace(packet, insertOffset), insertOffset, written + "\n" + indent})
+	}
+
+	return applyXMPEdits(packet, edits), nil
+}
+
+// properties returns the properties that are changed by the update
+func (update XMPSidecarUpdate) properties() []xmpProperty {
+	properties := make([]xmpProperty, 0)
+
+	if update.Title != nil {
+		properties = append(properties, xmpPropertyTitle)
+	}
+	if update.Description != nil {
+		properties = append(properties, xmpPropertyDescription)
+	}
+	if update.Rating != nil {
+		properties = append(properties, xmpPropertyRating)
+	}
+	if update.ColorLabel != nil {
+		properties = append(properties, xmpPropertyLabel)
+	}
+	if update.Keywords != nil {
+		properties = append(properties, xmpPropertySubject)
+	}
+	if update.HierarchicalKeywords != nil {
+		properties = append(properties, xmpPropertyHierarchicalSubject)
+	}
+
+	return properties
+}
+
+// writeXMPProperties returns the elements of the updated properties that have a value, each on a new line
+func writeXMPProperties(update XMPSidecarUpdate, prefixOf map[string]string, indent string) string {
+	var buf strings.Builder
+	rdf := func(name string) string { return qualifiedXMPName(prefixOf[xmpNamespaceRDF], name) }
+
+	writeArray := func(property xmpProperty, arrayType string, items []string, language bool) {
+		name := qualifiedXMPName(prefixOf[property.namespace], property.name)
+		fmt.Fprintf(&buf, "\n%s<%s>\n%s <%s>", indent, name, indent, rdf(arrayType))
+		for _, item := range items {
+			if language {
+				fmt.Fprintf(&buf, "\n%s  <%s xml:lang=\"x-default\">%s</%s>", indent, rdf("li"), escapeXMPText(item), rdf("li"))
+			} else {
+				fmt.Fprintf(&buf, "\n%s  <%s>%s</%s>", indent, rdf("li"), escapeXMPText(item), rdf("li"))
+			}
+		}
+		fmt.Fprintf(&buf, "\n%s </%s>\n%s</%s>", indent, rdf(arrayType), indent, name)
+	}
+
+	if update.Title != nil && *update.Title != "" {
+		writeArray(xmpPropertyTitle, "Alt", []string{*update.Title}, true)
+	}
+	if update.Description != nil && *update.Description != "" {
+		writeArray(xmpPropertyDescription, "Alt", []string{*update.Description}, true)
+	}
+	if update.Rating != nil {
+		name := qualifiedXMPName(prefixOf[xmpNamespaceXMP], xmpPropertyRating.name)
+		fmt.Fprintf(&buf, "\n%s<%s>%d</%s>", indent, name, *update.Rating, name)
+	}
+	if update.ColorLabel != nil && *update.ColorLabel != "" {
+		name := qualifiedXMPName(prefixOf[xmpNamespaceXMP], xmpPropertyLabel.name)
+		fmt.Fprintf(&buf, "\n%s<%s>%s</%s>", indent, name, escapeXMPText(*update.ColorLabel), name)
+	}
+	if update.Keywords != nil && len(*update.Keywords) > 0 {
+		writeArray(xmpPropertySubject, "Bag", *update.Keywords, false)
+	}
+	if update.HierarchicalKeywords != nil && len(*update.HierarchicalKeywords) > 0 {
+		writeArray(xmpPropertyHierarchicalSubject, "Bag", *update.HierarchicalKeywords, false)
+	}
+
+	return buf.String()
+}
+
+// removeXMPAttributes removes the updated properties written as attributes from the start tag of a description
+func removeXMPAttributes(tag string, attrs []xml.Attr, properties []xmpProperty, stack []xmpOpenElement) string {
+	for _, attr := range attrs {
+		for _, property := range properties {
+			if attr.Name.Space != property.namespace || attr.Name.Local != property.name {
+				continue
+			}
+
+			// The attribute may be written with any prefix bound to the namespace
+			for i := len(stack) - 1; i >= 0; i-- {
+				for prefix, namespace := range stack[i].prefixes {
+					if namespace != property.namespace || prefix == "" {
+						continue
+					}
+					attrPattern := regexp.MustCompile(`\s+` + regexp.QuoteMeta(prefix+":"+property.name) + `\s*=\s*("[^"]*"|'[^']*')`)
+					tag = attrPattern.ReplaceAllString(tag, "")
+				}
+			}
+		}
+	}
+
+	return tag
+}
+
+// lookupXMPPrefix returns the prefix bound to the namespace by the innermost of the open elements
+func lookupXMPPrefix(stack []xmpOpenElement, namespace string) (string, bool) {
+	for i := len(stack) - 1; i >= 0; i-- {
+		for prefix, bound := range stack[i].prefixes {
+			if bound == namespace && prefix != "" {
+				return prefix, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func isXMPPrefixBound(stack []xmpOpenElement, prefix string) bool {
+	for _, element := range stack {
+		if _, found := element.prefixes[prefix]; found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func qualifiedXMPName(prefix string, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + ":" + name
+}
+
+func escapeXMPText(text string) string {
+	var buf strings.Builder
+	xml.EscapeText(&buf, []byte(text))
+	return buf.String()
+}
+
+// trimLeadingWhitespace moves the offset back over the indentation and the line break in front of it
+func trimLeadingWhitespace(packet []byte, offset int64) int64 {
+	for offset > 0 && (packet[offset-1] == ' ' || packet[offset-1] == '\t') {
+		offset--
+	}
+	if offset > 0 && packet[offset-1] == '\n' {
+		offset--
+	}
+	if offset > 0 && packet[offset-1] == '\r' {
+		offset--
+	}
+	return offset
+}
+
+// lineIndentation returns the whitespace in front of the offset, if it starts its line
+func lineIndentation(packet []byte, offset int64) string {
+	start := offset
+	for start > 0 && (packet[start-1] == ' ' || packet[start-1] == '\t') {
+		start--
+	}
+	if start > 0 && packet[start-1] != '\n' {
+		return ""
+	}
+	return string(packet[start:offset])
+}
+
+func removeXMPEdit(edits []xmpEdit, start int64) []xmpEdit {
+	result := make([]xmpEdit, 0, len(edits))
+	for _, edit := range edits {
+		if edit.start != start {
+			result = append(result, edit)
+		}
+	}
+	return result
+}
+
+// applyXMPEdits replaces the ranges of the edits, which must not overlap
+func applyXMPEdits(packet []byte, edits []xmpEdit) []byte {
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+
+	var result bytes.Buffer
+	offset := int64(0)
+	for _, edit := range edits {
+		result.Write(packet[offset:edit.start])
+		result.WriteString(edit.replacement)
+		offset = edit.end
+	}
+	result.Write(packet[offset:])
+
+	return result.Bytes()
+}
@@ -0,0 +1,140 @@
+package exif_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateXMPPacket(t *testing.T) {
+	packet := `<x:xmpmeta xmlns:x="adobe:ns:meta/">
+  <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+    <rdf:Description rdf:about=""
+      xmlns:xmp="http://ns.adobe.com/xap/1.0/"
+      xmlns:dc="http://purl.org/dc/elements/1.1/"
+      xmlns:darktable="http://darktable.sf.net/"
+      xmp:Rating="2"
+      darktable:history_end="3">
+      <dc:title>
+        <rdf:Alt>
+          <rdf:li xml:lang="x-default">Old title</rdf:li>
+        </rdf:Alt>
+      </dc:title>
+      <dc:description>
+        <rdf:Alt>
+          <rdf:li xml:lang="x-default">Kept description</rdf:li>
+        </rdf:Alt>
+      </dc:description>
+      <darktable:history>
+        <rdf:Seq>
+          <rdf:li darktable:operation="exposure"/>
+        </rdf:Seq>
+      </darktable:history>
+    </rdf:Description>
+  </rdf:RDF>
+</x:xmpmeta>`
+
+	title := "Sunset & sea"
+	rating := int64(4)
+	keywords := []string{"beach", "summer"}
+	hierarchical := []string{"Places|Beach"}
+
+	updated, err := exif.UpdateXMPPacket([]byte(packet), exif.XMPSidecarUpdate{
+		Title:                &title,
+		Rating:               &rating,
+		Keywords:             &keywords,
+		HierarchicalKeywords: &hierarchical,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	properties, err := exif.ParseXMPProperties(updated)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	metadata := exif.ParseXMPMetadata(properties)
+	if !assert.NotNil(t, metadata) {
+		return
+	}
+
+	assert.Equal(t, "Sunset & sea", *metadata.Title)
+	assert.Equal(t, "Kept description", *metadata.Description)
+	assert.EqualValues(t, 4, *metadata.Rating)
+	assert.Equal(t, []string{"beach", "summer"}, metadata.Keywords)
+	assert.Equal(t, []string{"Places|Beach"}, properties.GetArray("http://ns.adobe.com/lightroom/1.0/", "hierarchicalSubject"))
+
+	historyEnd, found := properties.Get("http://darktable.sf.net/", "history_end")
+	assert.True(t, found)
+	assert.Equal(t, "3", historyEnd)
+	assert.Len(t, properties.GetArray("http://darktable.sf.net/", "history"), 1)
+
+	assert.NotContains(t, string(updated), "Old title")
+	assert.NotContains(t, string(updated), `xmp:Rating="2"`)
+
+	t.Run("Remove property", func(t *testing.T) {
+		empty := ""
+		removed, err := exif.UpdateXMPPacket(updated, exif.XMPSidecarUpdate{Description: &empty})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		properties, err := exif.ParseXMPProperties(removed)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		metadata := exif.ParseXMPMetadata(properties)
+		assert.Nil(t, metadata.Description)
+		assert.Equal(t, "Sunset & sea", *metadata.Title)
+	})
+}
+
+func TestWriteXMPSidecar(t *testing.T) {
+	dir := t.TempDir()
+	media := models.Media{Path: path.Join(dir, "photo.jpg")}
+
+	title := "A new title"
+	sidecarPath, sidecarHash, err := exif.WriteXMPSidecar(&media, exif.XMPSidecarUpdate{Title: &title})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, media.Path+".xmp", sidecarPath)
+
+	metadata, err := exif.ReadXMPMetadata(path.Join("test_data", "stripped.jpg"), &sidecarPath)
+	if assert.NoError(t, err) && assert.NotNil(t, metadata) {
+		assert.Equal(t, "A new title", *metadata.Title)
+	}
+
+	t.Run("Conflict with unknown sidecar", func(t *testing.T) {
+		_, _, err := exif.WriteXMPSidecar(&media, exif.XMPSidecarUpdate{Title: &title})
+		assert.ErrorIs(t, err, exif.ErrXMPSidecarConflict)
+	})
+
+	media.SideCarPath = &sidecarPath
+	media.SideCarHash = &sidecarHash
+
+	t.Run("Update imported sidecar", func(t *testing.T) {
+		rating := int64(5)
+		_, newHash, err := exif.WriteXMPSidecar(&media, exif.XMPSidecarUpdate{Rating: &rating})
+		if assert.NoError(t, err) {
+			media.SideCarHash = &newHash
+		}
+	})
+
+	t.Run("Conflict with external edit", func(t *testing.T) {
+		assert.NoError(t, os.WriteFile(sidecarPath, []byte("edited elsewhere"), 0644))
+
+		_, _, err := exif.WriteXMPSidecar(&media, exif.XMPSidecarUpdate{Title: &title})
+		assert.ErrorIs(t, err, exif.ErrXMPSidecarConflict)
+
+		content, err := os.ReadFile(sidecarPath)
+		assert.NoError(t, err)
+		assert.Equal(t, "edited elsewhere", string(content))
+	})
+}
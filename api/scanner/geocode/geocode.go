@@ -0,0 +1,274 @@
+// Package geocode finds the country, region and city of a location, using the cities of the GeoNames database
+// so no online service is needed.
+package geocode
+
+import (
+	"bufio"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+)
+
+// maxCityDistance is the furthest a location can be from the nearest city, in kilometers, for the city to be used
+const maxCityDistance = 100
+
+// kmPerDegree is the length of a degree of latitude, and of a degree of longitude at the equator
+const kmPerDegree = 111.2
+
+// Place is the country, region and city of a location, the region is empty if unknown
+type Place struct {
+	Country string
+	Region  string
+	City    string
+}
+
+type city struct {
+	name        string
+	latitude    float64
+	longitude   float64
+	countryCode string
+	admin1Code  string
+}
+
+// cell is the square of one degree of latitude and longitude holding a city
+type cell struct {
+	latitude, longitude int
+}
+
+// Cities holds the cities of a GeoNames extract, indexed by the square of one degree they are in
+type Cities struct {
+	cells     map[cell][]city
+	regions   map[string]string
+	countries map[string]string
+}
+
+// LoadCities reads the cities from a GeoNames extract such as `cities15000.txt`. The names of the regions and countries
+// are read from `admin1CodesASCII.txt` and `countryInfo.txt` in the same directory if present,
+// otherwise the regions are left out and the countries are given by their ISO code.
+func LoadCities(citiesPath string) (*Cities, error) {
+	file, err := os.Open(citiesPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "open GeoNames cities")
+	}
+	defer file.Close()
+
+	cities := Cities{
+		cells:     make(map[cell][]city),
+		regions:   make(map[string]string),
+		countries: make(map[string]string),
+	}
+
+	err = readTSV(file, func(fields []string) {
+		// The columns are described in the readme of the GeoNames dump
+		if len(fields) < 11 {
+			return
+		}
+
+		latitude, err := strconv.ParseFloat(fields[4], 64)
+		if err != nil {
+			return
+		}
+		longitude, err := strconv.ParseFloat(fields[5], 64)
+		if err != nil {
+			return
+		}
+
+		c := city{
+			name:        fields[1],
+			latitude:    latitude,
+			longitude:   longitude,
+			countryCode: fields[8],
+			admin1Code:  fields[10],
+		}
+
+		key := cellOf(latitude, longitude)
+		cities.cells[key] = append(cities.cells[key], c)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "read GeoNames cities (%s)", citiesPath)
+	}
+
+	dir := path.Dir(citiesPath)
+
+	if err := readOptionalTSV(path.Join(dir, "admin1CodesASCII.txt"), func(fields []string) {
+		if len(fields) >= 2 {
+			cities.regions[fields[0]] = fields[1]
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := readOptionalTSV(path.Join(dir, "countryInfo.txt"), func(fields []string) {
+		if len(fields) >= 5 {
+			cities.countries[fields[0]] = fields[4]
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return &cities, nil
+}
+
+// readTSV calls readLine with the fields of each line of a tab separated file, comment lines starting with # are skipped
+func readTSV(reader io.Reader, readLine func(fields []string)) error {
+	scanner := bufio.NewScanner(reader)
+	// The alternate names of large cities make very long lines
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		readLine(strings.Split(line, "\t"))
+	}
+
+	return scanner.Err()
+}
+
+func readOptionalTSV(filePath string, readLine func(fields []string)) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "open GeoNames file")
+	}
+	defer file.Close()
+
+	if err := readTSV(file, readLine); err != nil {
+		return errors.Wrapf(err, "read GeoNames file (%s)", filePath)
+	}
+
+	return nil
+}
+
+func cellOf(latitude, longitude float64) cell {
+	return cell{
+		latitude:  int(math.Floor(latitude)),
+		longitude: int(math.Floor(longitude)),
+	}
+}
+
+// Lookup returns the place of the city nearest to the coordinates, or nil if no city is close enough
+func (c *Cities) Lookup(latitude, longitude float64) *Place {
+	center := cellOf(latitude, longitude)
+
+	var nearest *city
+	nearestDistance := math.Inf(1)
+
+	// A degree of latitude is longer than the maximum distance, so the cities close enough are at most one square
+	// north or south. Degrees of longitude get shorter towards the poles, so more squares east and west are searched.
+	longitudeSquares := 180
+	if reach := math.Abs(latitude) + maxCityDistance/kmPerDegree; reach < 90 {
+		longitudeSquares = min(180, int(math.Ceil(maxCityDistance/(kmPerDegree*math.Cos(reach*math.Pi/180)))))
+	}
+
+	for dLat := -1; dLat <= 1; dLat++ {
+		for dLong := -longitudeSquares; dLong <= longitudeSquares; dLong++ {
+			key := cell{
+				latitude:  center.latitude + dLat,
+				longitude: wrapLongitude(center.longitude + dLong),
+			}
+
+			cities := c.cells[key]
+			for i := range cities {
+				distance := distanceKm(latitude, longitude, cities[i].latitude, cities[i].longitude)
+				if distance < nearestDistance {
+					nearest = &cities[i]
+					nearestDistance = distance
+				}
+			}
+		}
+	}
+
+	if nearest == nil || nearestDistance > maxCityDistance {
+		return nil
+	}
+
+	country := nearest.countryCode
+	if name, found := c.countries[nearest.countryCode]; found {
+		country = name
+	}
+
+	return &Place{
+		Country: country,
+		Region:  c.regions[nearest.countryCode+"."+nearest.admin1Code],
+		City:    nearest.name,
+	}
+}
+
+// wrapLongitude keeps the squares of longitude between -180 and 179 across the antimeridian
+func wrapLongitude(longitude int) int {
+	if longitude < -180 {
+		return longitude + 360
+	}
+	if longitude >= 180 {
+		return longitude - 360
+	}
+	return longitude
+}
+
+// distanceKm is the great-circle distance between two points, using the haversine formula
+func distanceKm(lat1, long1, lat2, long2 float64) float64 {
+	const earthRadius = 6371
+
+	toRadians := func(degrees float64) float64 {
+		return degrees * math.Pi / 180
+	}
+
+	dLat := toRadians(lat2 - lat1)
+	dLong := toRadians(long2 - long1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLong/2)*math.Sin(dLong/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+var (
+	globalCities     *Cities
+	loadGlobalCities sync.Once
+)
+
+// InitializeCities loads the cities from the GeoNames file set by the PHOTOVIEW_GEONAMES_CITIES environment variable,
+// the first time it is called. It returns nil, after logging why, if no cities are available.
+func InitializeCities() *Cities {
+	loadGlobalCities.Do(func() {
+		citiesPath := utils.EnvGeonamesCities.GetValue()
+		if citiesPath == "" {
+			log.Printf("%s is not set, media will not be given place names\n", utils.EnvGeonamesCities.GetName())
+			return
+		}
+
+		cities, err := LoadCities(citiesPath)
+		if err != nil {
+			log.Printf("WARN: could not load GeoNames cities, media will not be given place names: %s\n", err)
+			return
+		}
+
+		globalCities = cities
+	})
+
+	return globalCities
+}
+
+// ReverseGeocode returns the place at the coordinates, using the cities from InitializeCities.
+// It returns nil if the place is unknown.
+func ReverseGeocode(latitude, longitude float64) *Place {
+	cities := InitializeCities()
+	if cities == nil {
+		return nil
+	}
+
+	return cities.Lookup(latitude, longitude)
+}
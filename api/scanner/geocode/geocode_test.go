@@ -0,0 +1,73 @@
+package geocode_test
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/geocode"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(test_utils.UnitTestRun(m))
+}
+
+// geonamesLine formats a line of a GeoNames cities extract, leaving out the columns that are not read
+func geonamesLine(name, latitude, longitude, countryCode, admin1Code string) string {
+	fields := make([]string, 19)
+	fields[0] = "1"
+	fields[1] = name
+	fields[2] = name
+	fields[4] = latitude
+	fields[5] = longitude
+	fields[6] = "P"
+	fields[7] = "PPLA"
+	fields[8] = countryCode
+	fields[10] = admin1Code
+	return strings.Join(fields, "\t")
+}
+
+func TestLookup(t *testing.T) {
+	dir := t.TempDir()
+
+	cities := strings.Join([]string{
+		geonamesLine("Lisbon", "38.71667", "-9.13333", "PT", "14"),
+		geonamesLine("Rome", "41.89193", "12.51133", "IT", "07"),
+		geonamesLine("Tivoli", "41.95952", "12.80160", "IT", "07"),
+		geonamesLine("Waiyevo", "-16.78860", "-179.98680", "FJ", "N"),
+		geonamesLine("Near", "65", "27.5", "FI", "13"),
+		geonamesLine("Far", "65", "24.1", "FI", "13"),
+	}, "\n")
+	assert.NoError(t, os.WriteFile(path.Join(dir, "cities15000.txt"), []byte(cities), 0644))
+
+	regions := "PT.14\tLisbon\tLisbon\t2267056\nIT.07\tLatium\tLatium\t3174976\n"
+	assert.NoError(t, os.WriteFile(path.Join(dir, "admin1CodesASCII.txt"), []byte(regions), 0644))
+
+	countries := "#ISO\tISO3\tISO-Numeric\tfips\tCountry\n" +
+		"PT\tPRT\t620\tPO\tPortugal\n" +
+		"IT\tITA\t380\tIT\tItaly\n"
+	assert.NoError(t, os.WriteFile(path.Join(dir, "countryInfo.txt"), []byte(countries), 0644))
+
+	db, err := geocode.LoadCities(path.Join(dir, "cities15000.txt"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, &geocode.Place{Country: "Portugal", Region: "Lisbon", City: "Lisbon"}, db.Lookup(38.7223, -9.1393))
+	assert.Equal(t, &geocode.Place{Country: "Italy", Region: "Latium", City: "Rome"}, db.Lookup(41.9028, 12.4964))
+	assert.Equal(t, &geocode.Place{Country: "Italy", Region: "Latium", City: "Tivoli"}, db.Lookup(41.96, 12.79))
+
+	assert.Equal(t, &geocode.Place{Country: "FJ", City: "Waiyevo"}, db.Lookup(-16.8, 179.95),
+		"cities across the antimeridian are found, and countries without a name are given by their code")
+
+	assert.Equal(t, "Near", db.Lookup(65, 25.99).City,
+		"cities more than a degree of longitude away are found at high latitudes")
+
+	assert.Nil(t, db.Lookup(0, 0), "too far from any city")
+
+	_, err = geocode.LoadCities(path.Join(dir, "missing.txt"))
+	assert.Error(t, err)
+}
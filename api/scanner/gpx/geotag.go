@@ -0,0 +1,141 @@
+package gpx
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Options control how media are matched to the points of a track
+type Options struct {
+	// MaxGap is the longest time between the media and the track points used for its position
+	MaxGap time.Duration
+	// ClockOffset is added to the capture time of the media, to correct a camera clock that was wrong
+	ClockOffset time.Duration
+}
+
+// defaultMaxGap is used when PHOTOVIEW_GPX_MAX_GAP is not set
+const defaultMaxGap = 10 * time.Minute
+
+// DefaultOptions returns the options set by the PHOTOVIEW_GPX_MAX_GAP and PHOTOVIEW_GPX_CLOCK_OFFSET environment variables
+func DefaultOptions() Options {
+	return Options{
+		MaxGap:      durationFromEnv(utils.EnvGPXMaxGap, defaultMaxGap),
+		ClockOffset: durationFromEnv(utils.EnvGPXClockOffset, 0),
+	}
+}
+
+func durationFromEnv(env utils.EnvironmentVariable, defaultValue time.Duration) time.Duration {
+	value := env.GetValue()
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("WARN: invalid duration in %s, using %s instead: %s\n", env.GetName(), defaultValue, err)
+		return defaultValue
+	}
+
+	return duration
+}
+
+// MergeTracks combines the points of the tracks into a single track
+func MergeTracks(tracks ...*Track) *Track {
+	merged := Track{
+		Points: make([]Point, 0),
+	}
+
+	for _, track := range tracks {
+		merged.Points = append(merged.Points, track.Points...)
+	}
+
+	sort.SliceStable(merged.Points, func(i, j int) bool {
+		return merged.Points[i].Time.Before(merged.Points[j].Time)
+	})
+
+	return &merged
+}
+
+// CaptureTime returns the time of capture of the media in UTC. The capture date is stored as the local time
+// of the place of capture, when its offset from UTC is unknown it is taken to be in the timezone of the server.
+func CaptureTime(media *models.Media, clockOffset time.Duration) time.Time {
+	date := media.DateShot
+	var captureTime time.Time
+	if media.DateShotOffset != nil {
+		captureTime = date.Add(-time.Duration(*media.DateShotOffset) * time.Second)
+	} else {
+		captureTime = time.Date(date.Year(), date.Month(), date.Day(), date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), time.Local)
+	}
+
+	return captureTime.Add(clockOffset).UTC()
+}
+
+// GeotagMedia sets the location of the media without coordinates to the position of the track at their time of capture.
+// Media with a location read from their metadata or set by a user are left untouched, while locations found
+// from an earlier track are replaced. The locations are saved as overrides, so the media files are not modified.
+// The EXIF metadata of the media must be loaded. It returns the media whose location changed.
+func GeotagMedia(tx *gorm.DB, media []*models.Media, track *Track, options Options) ([]*models.Media, error) {
+	if len(media) == 0 || len(track.Points) == 0 {
+		return []*models.Media{}, nil
+	}
+
+	mediaIDs := make([]int, len(media))
+	for i, m := range media {
+		mediaIDs[i] = m.ID
+	}
+
+	var trackOverrides []*models.MediaOverride
+	if err := tx.Where("media_id IN (?) AND location_from_track = ?", mediaIDs, true).Find(&trackOverrides).Error; err != nil {
+		return nil, errors.Wrap(err, "get media locations found from tracks")
+	}
+
+	locatedFromTrack := make(map[int]bool)
+	for _, override := range trackOverrides {
+		locatedFromTrack[override.MediaID] = true
+	}
+
+	geotagged := make([]*models.Media, 0)
+	for _, m := range media {
+		if m.Exif != nil && m.Exif.Coordinates() != nil && !locatedFromTrack[m.ID] {
+			continue
+		}
+
+		if m.DateShot.IsZero() {
+			continue
+		}
+
+		position, found := track.Position(CaptureTime(m, options.ClockOffset), options.MaxGap)
+		if !found {
+			continue
+		}
+
+		// Scanning the album again finds the same positions, which do not need to be saved again
+		if m.Exif != nil {
+			if coordinates := m.Exif.Coordinates(); coordinates != nil &&
+				coordinates.Latitude == position.Latitude && coordinates.Longitude == position.Longitude {
+				continue
+			}
+		}
+
+		override := models.MediaOverride{
+			GPSLatitude:       &position.Latitude,
+			GPSLongitude:      &position.Longitude,
+			LocationFromTrack: true,
+		}
+
+		if err := exif.SaveMediaOverride(tx, m, &override, models.MediaOverrideLocationColumns); err != nil {
+			return nil, errors.Wrapf(err, "geotag media %s", m.Path)
+		}
+
+		geotagged = append(geotagged, m)
+	}
+
+	return geotagged, nil
+}
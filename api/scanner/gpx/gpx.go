@@ -0,0 +1,148 @@
+// Package gpx reads GPX tracks and finds the location of media from their capture time,
+// for cameras without a GPS receiver.
+package gpx
+
+import (
+	"encoding/xml"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Point is a position of a track at a given time
+type Point struct {
+	Time      time.Time
+	Latitude  float64
+	Longitude float64
+}
+
+// Track holds the points of all tracks and routes of a GPX file sorted by time
+type Track struct {
+	Points []Point
+}
+
+type gpxPoint struct {
+	Latitude  float64 `xml:"lat,attr"`
+	Longitude float64 `xml:"lon,attr"`
+	Time      string  `xml:"time"`
+}
+
+type gpxFile struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+	Routes []struct {
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+}
+
+// Parse reads the points of a GPX file, the points without a time are left out as they can not be matched to media
+func Parse(reader io.Reader) (*Track, error) {
+	var file gpxFile
+	if err := xml.NewDecoder(reader).Decode(&file); err != nil {
+		return nil, errors.Wrap(err, "decode GPX file")
+	}
+
+	gpxPoints := make([]gpxPoint, 0)
+	for _, track := range file.Tracks {
+		for _, segment := range track.Segments {
+			gpxPoints = append(gpxPoints, segment.Points...)
+		}
+	}
+	for _, route := range file.Routes {
+		gpxPoints = append(gpxPoints, route.Points...)
+	}
+
+	track := Track{
+		Points: make([]Point, 0, len(gpxPoints)),
+	}
+
+	for _, p := range gpxPoints {
+		if p.Time == "" {
+			continue
+		}
+
+		pointTime, err := time.Parse(time.RFC3339Nano, p.Time)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse time of GPX point (%s)", p.Time)
+		}
+
+		track.Points = append(track.Points, Point{
+			Time:      pointTime,
+			Latitude:  p.Latitude,
+			Longitude: p.Longitude,
+		})
+	}
+
+	sort.SliceStable(track.Points, func(i, j int) bool {
+		return track.Points[i].Time.Before(track.Points[j].Time)
+	})
+
+	return &track, nil
+}
+
+// ParseFile reads the points of the GPX file at the path
+func ParseFile(path string) (*Track, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open GPX file")
+	}
+	defer file.Close()
+
+	track, err := Parse(file)
+	if err != nil {
+		return nil, errors.Wrap(err, path)
+	}
+
+	return track, nil
+}
+
+// Position returns the position of the track at the given time. Between two points less than maxGap apart,
+// the position is interpolated linearly. Otherwise the nearest point is used if it is less than maxGap away,
+// and no position is found if it is not.
+func (track *Track) Position(at time.Time, maxGap time.Duration) (Point, bool) {
+	points := track.Points
+
+	// The first point after the time
+	next := sort.Search(len(points), func(i int) bool {
+		return points[i].Time.After(at)
+	})
+
+	if next > 0 && next < len(points) {
+		before, after := points[next-1], points[next]
+		if span := after.Time.Sub(before.Time); span <= maxGap {
+			if span == 0 {
+				return before, true
+			}
+
+			ratio := float64(at.Sub(before.Time)) / float64(span)
+			return Point{
+				Time:      at,
+				Latitude:  before.Latitude + (after.Latitude-before.Latitude)*ratio,
+				Longitude: before.Longitude + (after.Longitude-before.Longitude)*ratio,
+			}, true
+		}
+	}
+
+	var nearest *Point
+	for _, i := range []int{next - 1, next} {
+		if i < 0 || i >= len(points) {
+			continue
+		}
+
+		if nearest == nil || points[i].Time.Sub(at).Abs() < nearest.Time.Sub(at).Abs() {
+			nearest = &points[i]
+		}
+	}
+
+	if nearest == nil || nearest.Time.Sub(at).Abs() > maxGap {
+		return Point{}, false
+	}
+
+	return *nearest, true
+}
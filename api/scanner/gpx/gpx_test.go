@@ -0,0 +1,170 @@
+package gpx_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/gpx"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(test_utils.IntegrationTestRun(m))
+}
+
+// The track starts at 10:00 UTC and has a point every 10 minutes until 10:20, then resumes an hour later
+const testTrack = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk>
+    <trkseg>
+      <trkpt lat="48.0" lon="2.0"><time>2023-06-01T10:00:00Z</time></trkpt>
+      <trkpt lat="48.1" lon="2.2"><time>2023-06-01T10:10:00Z</time></trkpt>
+      <trkpt lat="48.2" lon="2.4"><time>2023-06-01T10:20:00Z</time></trkpt>
+      <trkpt lat="49.0" lon="3.0"></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="50.0" lon="4.0"><time>2023-06-01T11:20:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestTrackPosition(t *testing.T) {
+	track, err := gpx.Parse(strings.NewReader(testTrack))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, track.Points, 4, "points without a time are left out")
+
+	positionAt := func(hour, minute int) (gpx.Point, bool) {
+		return track.Position(time.Date(2023, 6, 1, hour, minute, 0, 0, time.UTC), 15*time.Minute)
+	}
+
+	position, found := positionAt(10, 5)
+	if assert.True(t, found) {
+		assert.InDelta(t, 48.05, position.Latitude, 0.0001)
+		assert.InDelta(t, 2.1, position.Longitude, 0.0001)
+	}
+
+	position, found = positionAt(10, 20)
+	if assert.True(t, found) {
+		assert.InDelta(t, 48.2, position.Latitude, 0.0001)
+	}
+
+	position, found = positionAt(10, 30)
+	if assert.True(t, found, "the nearest point is used across a long gap") {
+		assert.InDelta(t, 48.2, position.Latitude, 0.0001)
+	}
+
+	_, found = positionAt(10, 50)
+	assert.False(t, found, "too far from any point")
+
+	_, found = positionAt(9, 30)
+	assert.False(t, found, "before the start of the track")
+
+	_, err = gpx.Parse(strings.NewReader("<gpx><trk><trkseg><trkpt><time>yesterday</time></trkpt></trkseg></trk></gpx>"))
+	assert.Error(t, err)
+}
+
+func TestCaptureTime(t *testing.T) {
+	offset := 2 * 3600
+	media := models.Media{
+		DateShot:       time.Date(2023, 6, 1, 12, 5, 0, 0, time.UTC),
+		DateShotOffset: &offset,
+	}
+
+	assert.Equal(t, time.Date(2023, 6, 1, 10, 5, 0, 0, time.UTC), gpx.CaptureTime(&media, 0))
+	assert.Equal(t, time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC), gpx.CaptureTime(&media, -5*time.Minute))
+}
+
+func TestGeotagMedia(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/photos",
+	}
+	assert.NoError(t, db.Save(&album).Error)
+
+	offset := 2 * 3600
+	latitude, longitude := 10.0, 20.0
+	camera := "Fujifilm X100V"
+
+	newMedia := func(title string, hour, minute int, exif *models.MediaEXIF) *models.Media {
+		return &models.Media{
+			Title:          title,
+			Path:           "/photos/" + title,
+			AlbumID:        album.ID,
+			Type:           models.MediaTypePhoto,
+			DateShot:       time.Date(2023, 6, 1, hour, minute, 0, 0, time.UTC),
+			DateShotOffset: &offset,
+			Exif:           exif,
+		}
+	}
+
+	media := []*models.Media{
+		newMedia("no_exif.jpg", 12, 5, nil),
+		newMedia("no_location.jpg", 12, 10, &models.MediaEXIF{Camera: &camera}),
+		newMedia("located.jpg", 12, 10, &models.MediaEXIF{GPSLatitude: &latitude, GPSLongitude: &longitude}),
+		newMedia("off_track.jpg", 15, 0, nil),
+	}
+	assert.NoError(t, db.Save(&media).Error)
+
+	track, err := gpx.Parse(strings.NewReader(testTrack))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	loadMedia := func(t *testing.T) []*models.Media {
+		var result []*models.Media
+		assert.NoError(t, db.Preload("Exif").Order("id").Find(&result).Error)
+		return result
+	}
+
+	geotagged, err := gpx.GeotagMedia(db, loadMedia(t), track, gpx.Options{MaxGap: 15 * time.Minute})
+	assert.NoError(t, err)
+	assert.Len(t, geotagged, 2)
+
+	result := loadMedia(t)
+	assert.Equal(t, &models.Coordinates{Latitude: 48.05, Longitude: 2.1}, roundCoordinates(result[0].Exif.Coordinates()))
+	assert.Equal(t, &models.Coordinates{Latitude: 48.1, Longitude: 2.2}, roundCoordinates(result[1].Exif.Coordinates()))
+	assert.Equal(t, camera, *result[1].Exif.Camera, "the other metadata is kept")
+	assert.Equal(t, &models.Coordinates{Latitude: 10, Longitude: 20}, result[2].Exif.Coordinates(), "locations from the metadata are kept")
+	assert.Nil(t, result[3].Exif)
+
+	override, err := models.FindMediaOverride(db, media[0].ID)
+	if assert.NoError(t, err) && assert.NotNil(t, override) {
+		assert.True(t, override.LocationFromTrack)
+	}
+
+	t.Run("Import again with a clock offset", func(t *testing.T) {
+		geotagged, err := gpx.GeotagMedia(db, loadMedia(t), track, gpx.Options{MaxGap: 15 * time.Minute, ClockOffset: 5 * time.Minute})
+		assert.NoError(t, err)
+		assert.Len(t, geotagged, 2, "locations from an earlier track are replaced")
+
+		result := loadMedia(t)
+		assert.Equal(t, &models.Coordinates{Latitude: 48.1, Longitude: 2.2}, roundCoordinates(result[0].Exif.Coordinates()))
+
+		geotagged, err = gpx.GeotagMedia(db, loadMedia(t), track, gpx.Options{MaxGap: 15 * time.Minute, ClockOffset: 5 * time.Minute})
+		assert.NoError(t, err)
+		assert.Empty(t, geotagged, "unchanged locations are not saved again")
+	})
+}
+
+func roundCoordinates(coordinates *models.Coordinates) *models.Coordinates {
+	if coordinates == nil {
+		return nil
+	}
+
+	round := func(value float64) float64 {
+		return float64(int64(value*10000+0.5)) / 10000
+	}
+
+	return &models.Coordinates{
+		Latitude:  round(coordinates.Latitude),
+		Longitude: round(coordinates.Longitude),
+	}
+}
@@ -0,0 +1,223 @@
+package media_encoding
+
+import (
+	"log"
+	"math"
+	"os"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+)
+
+// defaultMetadataPolicies keep the capture date and camera in the downloadable high-res images,
+// while thumbnails are kept as small as possible
+var defaultMetadataPolicies = map[models.MediaPurpose]media_utils.MetadataPolicy{
+	models.PhotoHighRes:   media_utils.MetadataKeepBasic,
+	models.PhotoThumbnail: media_utils.MetadataStrip,
+}
+
+var metadataPolicyVariables = map[models.MediaPurpose]utils.EnvironmentVariable{
+	models.PhotoHighRes:   utils.EnvHighResMetadata,
+	models.PhotoThumbnail: utils.EnvThumbnailMetadata,
+}
+
+// MetadataPolicy returns the policy for copying the metadata of the original photo into images of the given purpose
+func MetadataPolicy(purpose models.MediaPurpose) media_utils.MetadataPolicy {
+	defaultPolicy, found := defaultMetadataPolicies[purpose]
+	if !found {
+		return media_utils.MetadataStrip
+	}
+
+	variable := metadataPolicyVariables[purpose]
+	if variable.GetValue() == "" {
+		return defaultPolicy
+	}
+
+	policy, err := media_utils.ParseMetadataPolicy(variable.GetValue())
+	if err != nil {
+		log.Printf("WARN: %s: %s, using %s instead\n", variable.GetName(), err, defaultPolicy)
+		return defaultPolicy
+	}
+
+	return policy
+}
+
+// copyDerivedMetadata replaces the metadata of the generated JPEG image with the metadata of the original photo,
+// according to the policy of the purpose. The image is expected to already be rotated, so the orientation is reset.
+func copyDerivedMetadata(originalPath string, outputPath string, purpose models.MediaPurpose) error {
+	policy := MetadataPolicy(purpose)
+
+	outputData, err := os.ReadFile(outputPath)
+	if err != nil {
+		return errors.Wrapf(err, "read generated image (%s)", outputPath)
+	}
+
+	var segments []media_utils.JpegSegment
+
+	switch policy {
+	case media_utils.MetadataKeepAll:
+		segments, err = readJpegMetadata(originalPath)
+		if err != nil {
+			return err
+		}
+
+		// Metadata can only be copied as is from JPEG files, for other formats the known tags are written instead
+		if segments == nil {
+			segments, err = buildExifMetadata(originalPath, true)
+		}
+	case media_utils.MetadataKeepBasic:
+		segments, err = buildExifMetadata(originalPath, false)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	outputData, err = media_utils.ReplaceJpegMetadata(outputData, segments)
+	if err != nil {
+		return errors.Wrapf(err, "replace metadata of generated image (%s)", outputPath)
+	}
+
+	if err := os.WriteFile(outputPath, outputData, 0644); err != nil {
+		return errors.Wrapf(err, "write generated image (%s)", outputPath)
+	}
+
+	return nil
+}
+
+// readJpegMetadata returns the metadata segments of the original photo, or nil if it is not a JPEG file
+func readJpegMetadata(originalPath string) ([]media_utils.JpegSegment, error) {
+	originalData, err := os.ReadFile(originalPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read original photo (%s)", originalPath)
+	}
+
+	if len(originalData) < 2 || originalData[0] != 0xFF || originalData[1] != 0xD8 {
+		return nil, nil
+	}
+
+	segments, err := media_utils.ReadJpegMetadata(originalData)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read metadata of original photo (%s)", originalPath)
+	}
+
+	for i := range segments {
+		if segments[i], err = media_utils.ResetExifOrientation(segments[i]); err != nil {
+			return nil, errors.Wrapf(err, "reset orientation of original photo metadata (%s)", originalPath)
+		}
+	}
+
+	return segments, nil
+}
+
+// buildExifMetadata writes the parsed EXIF of the original photo to a new EXIF segment.
+// Only the date, camera and orientation are written, unless all tags are included.
+func buildExifMetadata(originalPath string, allTags bool) ([]media_utils.JpegSegment, error) {
+	parsedExif, err := exif.ParseEXIF(originalPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse metadata of original photo (%s)", originalPath)
+	}
+
+	writer := media_utils.NewExifWriter()
+	writer.SetShort(media_utils.ExifIFD0, 0x0112, 1)                    // Orientation
+	writer.SetUndefined(media_utils.ExifSubIFD, 0x9000, []byte("0232")) // ExifVersion
+
+	if parsedExif == nil {
+		return []media_utils.JpegSegment{writer.Segment()}, nil
+	}
+
+	setString := func(directory media_utils.ExifDirectory, tag uint16, value *string) {
+		if value != nil && *value != "" {
+			writer.SetASCII(directory, tag, *value)
+		}
+	}
+
+	setString(media_utils.ExifIFD0, 0x010F, parsedExif.Maker)  // Make
+	setString(media_utils.ExifIFD0, 0x0110, parsedExif.Camera) // Model
+	setString(media_utils.ExifSubIFD, 0xA434, parsedExif.Lens) // LensModel
+
+	if parsedExif.DateShot != nil {
+		// Dates are stored as the local time of the place of capture
+		date := parsedExif.DateShot.UTC().Format("2006:01:02 15:04:05")
+		writer.SetASCII(media_utils.ExifIFD0, 0x0132, date)   // DateTime
+		writer.SetASCII(media_utils.ExifSubIFD, 0x9003, date) // DateTimeOriginal
+		writer.SetASCII(media_utils.ExifSubIFD, 0x9004, date) // DateTimeDigitized
+
+		if parsedExif.DateShotOffset != nil {
+			offset := models.LocalDate(*parsedExif.DateShot, *parsedExif.DateShotOffset).Format("-07:00")
+			writer.SetASCII(media_utils.ExifSubIFD, 0x9010, offset) // OffsetTime
+			writer.SetASCII(media_utils.ExifSubIFD, 0x9011, offset) // OffsetTimeOriginal
+		}
+	}
+
+	if !allTags {
+		return []media_utils.JpegSegment{writer.Segment()}, nil
+	}
+
+	setString(media_utils.ExifIFD0, 0x010E, parsedExif.Description) // ImageDescription
+
+	if parsedExif.Exposure != nil && *parsedExif.Exposure > 0 {
+		writer.SetRationals(media_utils.ExifSubIFD, 0x829A, exposureRational(*parsedExif.Exposure)) // ExposureTime
+	}
+	if parsedExif.Aperture != nil {
+		writer.SetRationals(media_utils.ExifSubIFD, 0x829D, decimalRational(*parsedExif.Aperture)) // FNumber
+	}
+	if parsedExif.FocalLength != nil {
+		writer.SetRationals(media_utils.ExifSubIFD, 0x920A, decimalRational(*parsedExif.FocalLength)) // FocalLength
+	}
+	if parsedExif.Iso != nil {
+		writer.SetShort(media_utils.ExifSubIFD, 0x8827, uint16(min(max(*parsedExif.Iso, 0), math.MaxUint16))) // ISOSpeedRatings
+	}
+	if parsedExif.Flash != nil {
+		writer.SetShort(media_utils.ExifSubIFD, 0x9209, uint16(*parsedExif.Flash)) // Flash
+	}
+	if parsedExif.ExposureProgram != nil {
+		writer.SetShort(media_utils.ExifSubIFD, 0x8822, uint16(*parsedExif.ExposureProgram)) // ExposureProgram
+	}
+
+	if coordinates := parsedExif.Coordinates(); coordinates != nil {
+		latitudeRef, longitudeRef := "N", "E"
+		if coordinates.Latitude < 0 {
+			latitudeRef = "S"
+		}
+		if coordinates.Longitude < 0 {
+			longitudeRef = "W"
+		}
+
+		writer.SetBytes(media_utils.ExifGPSIFD, 0x0000, []byte{2, 3, 0, 0}) // GPSVersionID
+		writer.SetASCII(media_utils.ExifGPSIFD, 0x0001, latitudeRef)
+		writer.SetRationals(media_utils.ExifGPSIFD, 0x0002, degreesRationals(math.Abs(coordinates.Latitude))...)
+		writer.SetASCII(media_utils.ExifGPSIFD, 0x0003, longitudeRef)
+		writer.SetRationals(media_utils.ExifGPSIFD, 0x0004, degreesRationals(math.Abs(coordinates.Longitude))...)
+	}
+
+	return []media_utils.JpegSegment{writer.Segment()}, nil
+}
+
+// exposureRational returns exposure times shorter than a second as 1/x, as cameras do
+func exposureRational(seconds float64) [2]uint32 {
+	if seconds < 1 {
+		return [2]uint32{1, uint32(math.Round(1 / seconds))}
+	}
+	return decimalRational(seconds)
+}
+
+func decimalRational(value float64) [2]uint32 {
+	return [2]uint32{uint32(math.Round(value * 100)), 100}
+}
+
+// degreesRationals returns the coordinate as degrees, minutes and seconds
+func degreesRationals(coordinate float64) [][2]uint32 {
+	degrees := math.Floor(coordinate)
+	minutes := math.Floor((coordinate - degrees) * 60)
+	seconds := ((coordinate-degrees)*60 - minutes) * 60
+
+	return [][2]uint32{
+		{uint32(degrees), 1},
+		{uint32(minutes), 1},
+		{uint32(math.Round(seconds * 10000)), 10000},
+	}
+}
@@ -1,14 +1,17 @@
 package media_encoding
 
 import (
+	"bytes"
 	"context"
 	"image"
 	"image/jpeg"
+	"log"
 	"os"
 	"time"
 
 	"github.com/disintegration/imaging"
 	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
 	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
 	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
 	"github.com/photoview/photoview/api/scanner/media_type"
@@ -30,7 +33,8 @@ var thumbFilter = map[models.ThumbnailFilter]imaging.ResampleFilter{
 	models.ThumbnailFilterLanczos:	imaging.Lanczos,
 }
 
-func EncodeThumbnail(db *gorm.DB, inputPath string, outputPath string) (*media_utils.PhotoDimensions, error) {
+// EncodeThumbnail scales down the input image, and copies the metadata of the original photo according to the thumbnail metadata policy
+func EncodeThumbnail(db *gorm.DB, inputPath string, outputPath string, originalPath string) (*media_utils.PhotoDimensions, error) {
 
 	var siteInfo models.SiteInfo
 	if err := db.First(&siteInfo).Error; err != nil {
@@ -41,6 +45,14 @@ func EncodeThumbnail(db *gorm.DB, inputPath string, outputPath string) (*media_u
 	if err != nil {
 		return nil, err
 	}
+	inputImage = convertToSRGB(inputPath, inputImage)
+
+	// Equirectangular panoramas are unrecognizable when scaled down as a whole, so only the center is shown
+	if panorama, err := exif.ReadPhotoPanorama(originalPath); err != nil {
+		log.Printf("WARN: could not read panorama metadata for thumbnail (%s): %s\n", originalPath, err)
+	} else if panorama != nil && panorama.IsEquirectangular() {
+		inputImage = imaging.Crop(inputImage, panoramaThumbnailRect(inputImage.Bounds(), panorama))
+	}
 
 	dimensions := media_utils.PhotoDimensionsFromRect(inputImage.Bounds())
 	dimensions = dimensions.ThumbnailScale()
@@ -50,28 +62,79 @@ func EncodeThumbnail(db *gorm.DB, inputPath string, outputPath string) (*media_u
 		return nil, err
 	}
 
+	if err := copyDerivedMetadata(originalPath, outputPath, models.PhotoThumbnail); err != nil {
+		log.Printf("WARN: could not copy metadata to thumbnail (%s): %s\n", originalPath, err)
+	}
+
 	return &dimensions, nil
 }
 
+// The field of view in degrees shown in the thumbnails of equirectangular panoramas
+const (
+	panoramaThumbnailHorizontalFOV = 135
+	panoramaThumbnailVerticalFOV   = 90
+)
+
+// panoramaThumbnailRect returns the center of the equirectangular panorama, spanning the field of view of the thumbnail
+func panoramaThumbnailRect(bounds image.Rectangle, panorama *models.MediaPanorama) image.Rectangle {
+	// The full panorama spans 360 degrees horizontally, of which the image may only cover a part
+	fullWidth := float64(bounds.Dx())
+	if panorama.FullPanoWidth != nil && panorama.CroppedAreaImageWidth != nil && *panorama.CroppedAreaImageWidth > 0 {
+		fullWidth = fullWidth * float64(*panorama.FullPanoWidth) / float64(*panorama.CroppedAreaImageWidth)
+	}
+	pixelsPerDegree := fullWidth / 360
+
+	width := min(bounds.Dx(), int(panoramaThumbnailHorizontalFOV*pixelsPerDegree))
+	height := min(bounds.Dy(), int(panoramaThumbnailVerticalFOV*pixelsPerDegree))
+
+	center := image.Pt(bounds.Min.X+bounds.Dx()/2, bounds.Min.Y+bounds.Dy()/2)
+	topLeft := center.Sub(image.Pt(width/2, height/2))
+
+	return image.Rectangle{Min: topLeft, Max: topLeft.Add(image.Pt(width, height))}
+}
+
 func encodeImageJPEG(image image.Image, outputPath string, jpegQuality int) error {
-	photo_file, err := os.Create(outputPath)
-	if err != nil {
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, image, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return err
+	}
+
+	jpegData := encoded.Bytes()
+	if utils.EnvEmbedSRGBProfile.GetBool() {
+		var err error
+		if jpegData, err = media_utils.EmbedJpegICCProfile(jpegData, media_utils.SRGBProfile()); err != nil {
+			return errors.Wrapf(err, "embed sRGB profile: %s", outputPath)
+		}
+	}
+
+	if err := os.WriteFile(outputPath, jpegData, 0644); err != nil {
 		return errors.Wrapf(err, "could not create file: %s", outputPath)
 	}
-	defer photo_file.Close()
 
-	err = jpeg.Encode(photo_file, image, &jpeg.Options{Quality: jpegQuality})
+	return nil
+}
+
+// convertToSRGB converts the pixels of the image to sRGB, if the image file embeds a different colour profile.
+// Browsers assume sRGB for images without a profile, so wide gamut photos would otherwise look washed out.
+func convertToSRGB(imagePath string, img image.Image) image.Image {
+	profile, err := media_utils.ReadColorProfile(imagePath)
 	if err != nil {
-		return err
+		log.Printf("WARN: could not read color profile, treating image as sRGB (%s): %s\n", imagePath, err)
+		return img
 	}
 
-	return nil
+	if profile == nil || profile.IsSRGB() {
+		return img
+	}
+
+	return media_utils.ConvertToSRGB(img, profile)
 }
 
 // EncodeMediaData is used to easily decode media data, with a cache so expensive operations are not repeated
 type EncodeMediaData struct {
 	Media           *models.Media
 	CounterpartPath *string
+	MotionVideoPath *string
 	_photoImage     image.Image
 	_contentType    *media_type.MediaType
 	_videoMetadata  *ffprobe.ProbeData
@@ -108,16 +171,25 @@ func (img *EncodeMediaData) EncodeHighRes(outputPath string) error {
 		return errors.New("could not convert photo as file format is not supported")
 	}
 
-	// Use darktable if there is no counterpart JPEG file to use instead
+	// Use the RAW converters if there is no counterpart JPEG file to use instead,
+	// trying the next converter in the preference order whenever one fails
 	if contentType.IsRaw() && img.CounterpartPath == nil {
-		if executable_worker.DarktableCli.IsInstalled() {
-			err := executable_worker.DarktableCli.EncodeJpeg(img.Media.Path, outputPath, 70)
-			if err != nil {
-				return err
-			}
-		} else {
+		if !executable_worker.HasRawConverter() {
 			return errors.New("could not convert photo as no RAW converter was found")
 		}
+
+		var err error
+		for _, converter := range executable_worker.RawConverters {
+			if err = converter.EncodeJpeg(img.Media.Path, outputPath, 70); err == nil {
+				break
+			}
+
+			log.Printf("WARN: RAW converter %s failed (%s): %s\n", converter.Name(), img.Media.Path, err)
+		}
+
+		if err != nil {
+			return errors.Wrap(err, "all RAW converters failed")
+		}
 	} else {
 		image, err := img.photoImage()
 		if err != nil {
@@ -127,6 +199,10 @@ func (img *EncodeMediaData) EncodeHighRes(outputPath string) error {
 		encodeImageJPEG(image, outputPath, 70)
 	}
 
+	if err := copyDerivedMetadata(img.Media.Path, outputPath, models.PhotoHighRes); err != nil {
+		log.Printf("WARN: could not copy metadata to high-res image (%s): %s\n", img.Media.Path, err)
+	}
+
 	return nil
 }
 
@@ -178,7 +254,7 @@ func (img *EncodeMediaData) decodeImage(imagePath string) (image.Image, error) {
 		}
 	}
 
-	return decodedImage, nil
+	return convertToSRGB(imagePath, decodedImage), nil
 }
 
 func (enc *EncodeMediaData) VideoMetadata() (*ffprobe.ProbeData, error) {
@@ -0,0 +1,89 @@
+package executable_worker
+
+import (
+	"bytes"
+	"image/jpeg"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+	"golang.org/x/image/tiff"
+)
+
+// DcrawWorker develops RAW files using dcraw_emu from LibRaw, or the original dcraw
+type DcrawWorker struct {
+	path      string
+	isLibRaw  bool
+	extraArgs []string
+}
+
+func newDcrawWorker() *DcrawWorker {
+	if utils.EnvDisableRawProcessing.GetBool() {
+		log.Printf("Executable worker disabled (%s=1): dcraw\n", utils.EnvDisableRawProcessing.GetName())
+		return nil
+	}
+
+	worker := DcrawWorker{
+		extraArgs: strings.Fields(utils.EnvDcrawArguments.GetValue()),
+	}
+
+	if path, err := exec.LookPath("dcraw_emu"); err == nil {
+		worker.path = path
+		worker.isLibRaw = true
+	} else if path, err := exec.LookPath("dcraw"); err == nil {
+		worker.path = path
+	} else {
+		log.Println("Executable worker not found: dcraw")
+		return nil
+	}
+
+	log.Printf("Found executable worker: dcraw (%s)\n", worker.path)
+
+	return &worker
+}
+
+func (worker *DcrawWorker) Name() string {
+	return "dcraw"
+}
+
+func (worker *DcrawWorker) IsInstalled() bool {
+	return worker != nil
+}
+
+func (worker *DcrawWorker) EncodeJpeg(inputPath string, outputPath string, jpegQuality int) error {
+	// Use the camera white balance and write a TIFF image to stdout
+	args := []string{"-w", "-T"}
+	if worker.isLibRaw {
+		args = append(args, "-Z", "-")
+	} else {
+		args = append(args, "-c")
+	}
+
+	args = append(args, worker.extraArgs...)
+	args = append(args, inputPath)
+
+	cmd := exec.Command(worker.path, args...)
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "encoding image using: %s %v", worker.path, args)
+	}
+
+	img, err := tiff.Decode(&stdout)
+	if err != nil {
+		return errors.Wrapf(err, "decode output of %s (%s)", worker.path, inputPath)
+	}
+
+	outputFile, err := os.Create(outputPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not create file: %s", outputPath)
+	}
+	defer outputFile.Close()
+
+	return jpeg.Encode(outputFile, img, &jpeg.Options{Quality: jpegQuality})
+}
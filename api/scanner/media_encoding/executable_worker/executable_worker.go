@@ -2,23 +2,28 @@ package executable_worker
 
 import (
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"log"
+	"math"
 	"os"
 	"os/exec"
+	"path"
+	"path/filepath"
 	"strings"
 
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
 	"github.com/photoview/photoview/api/utils"
 	"github.com/pkg/errors"
 	"gopkg.in/vansante/go-ffprobe.v2"
 )
 
 func InitializeExecutableWorkers() {
-	DarktableCli = newDarktableWorker()
+	RawConverters = initializeRawConverters()
 	FfmpegCli = newFfmpegWorker()
+	ActiveVideoProfile = loadActiveVideoProfile()
 }
 
-var DarktableCli *DarktableWorker = nil
 var FfmpegCli *FfmpegWorker = nil
 
 type ExecutableWorker interface {
@@ -26,7 +31,9 @@ type ExecutableWorker interface {
 }
 
 type DarktableWorker struct {
-	path string
+	path      string
+	style     *string
+	configDir *string
 }
 
 type FfmpegWorker struct {
@@ -51,9 +58,19 @@ func newDarktableWorker() *DarktableWorker {
 
 		log.Printf("Found executable worker: darktable (%s)\n", strings.Split(string(version), "\n")[0])
 
-		return &DarktableWorker{
+		worker := DarktableWorker{
 			path: path,
 		}
+
+		if style := utils.EnvDarktableStyle.GetValue(); style != "" {
+			worker.style = &style
+		}
+
+		if configDir := utils.EnvDarktableConfigDir.GetValue(); configDir != "" {
+			worker.configDir = &configDir
+		}
+
+		return &worker
 	}
 
 	return nil
@@ -85,6 +102,10 @@ func newFfmpegWorker() *FfmpegWorker {
 	return nil
 }
 
+func (worker *DarktableWorker) Name() string {
+	return "darktable"
+}
+
 func (worker *DarktableWorker) IsInstalled() bool {
 	return worker != nil
 }
@@ -94,21 +115,38 @@ func (worker *FfmpegWorker) IsInstalled() bool {
 }
 
 func (worker *DarktableWorker) EncodeJpeg(inputPath string, outputPath string, jpegQuality int) error {
-	tmpDir, err := ioutil.TempDir("/tmp", "photoview-darktable")
+	// darktable locks the library of its config directory, so every call gets its own throwaway directory,
+	// into which the styles and settings of the configured directory are copied
+	configDir, err := ioutil.TempDir("/tmp", "photoview-darktable")
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer os.RemoveAll(tmpDir)
+	defer os.RemoveAll(configDir)
+
+	if worker.configDir != nil {
+		if err := copyDarktableConfig(*worker.configDir, configDir); err != nil {
+			return errors.Wrapf(err, "copy darktable config directory (%s)", *worker.configDir)
+		}
+	}
 
 	args := []string{
 		inputPath,
 		outputPath,
+	}
+
+	if worker.style != nil {
+		args = append(args, "--style", *worker.style)
+	}
+
+	args = append(args,
 		"--core",
 		"--conf",
 		fmt.Sprintf("plugins/imageio/format/jpeg/quality=%d", jpegQuality),
 		"--configdir",
-		tmpDir,
-	}
+		configDir,
+		"--library",
+		":memory:",
+	)
 
 	cmd := exec.Command(worker.path, args...)
 
@@ -119,37 +157,140 @@ func (worker *DarktableWorker) EncodeJpeg(inputPath string, outputPath string, j
 	return nil
 }
 
+// darktableConfigFiles are the files and directories of a darktable config directory needed to apply styles,
+// the libraries and their locks are left out
+var darktableConfigFiles = []string{"darktablerc", "styles"}
+
+// copyDarktableConfig copies the settings and styles of the darktable config directory to the target directory
+func copyDarktableConfig(sourceDir string, targetDir string) error {
+	for _, name := range darktableConfigFiles {
+		sourcePath := path.Join(sourceDir, name)
+		if _, err := os.Stat(sourcePath); os.IsNotExist(err) {
+			continue
+		}
+
+		err := filepath.WalkDir(sourcePath, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			relativePath, err := filepath.Rel(sourceDir, filePath)
+			if err != nil {
+				return err
+			}
+			targetPath := path.Join(targetDir, relativePath)
+
+			if entry.IsDir() {
+				return os.MkdirAll(targetPath, 0700)
+			}
+
+			data, err := os.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(targetPath, data, 0600)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EncodeMp4 transcodes the video using the default profile, for clips that should play everywhere regardless of the active profile
 func (worker *FfmpegWorker) EncodeMp4(inputPath string, outputPath string) error {
-	args := []string{
-		"-i",
-		inputPath,
-		"-vcodec", "h264",
-		"-acodec", "aac",
-		"-vf", "scale='min(1080,iw)':'min(1080,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2",
-		"-movflags", "+faststart+use_metadata_tags",
-		outputPath,
+	return worker.EncodeVideo(inputPath, outputPath, &DefaultVideoProfile)
+}
+
+// posterCandidatePositions are the relative positions in the video searched for a poster frame, in order of preference
+var posterCandidatePositions = []float64{0.25, 0.4, 0.1, 0.55, 0.7}
+
+// equirectangularThumbnailCrop shows the center of spherical videos, spanning 135 by 90 degrees like photo panoramas
+const equirectangularThumbnailCrop = "crop='min(iw,ih*3/4)':'ih/2'"
+
+func isEquirectangularVideo(probeData *ffprobe.ProbeData) bool {
+	stream := probeData.FirstVideoStream()
+	if stream == nil {
+		return false
 	}
 
-	cmd := exec.Command(worker.path, args...)
+	mapping, err := stream.SideDataList.GetSphericalMapping()
+	return err == nil && strings.Contains(strings.ToLower(mapping.Projection), "equirectangular")
+}
 
-	if err := cmd.Run(); err != nil {
-		return errors.Wrapf(err, "encoding video using: %s", worker.path)
+// EncodeVideoThumbnail saves a poster frame of the video, taken at the given timestamp in seconds if not nil.
+// Otherwise the most representative frame around each candidate position is considered,
+// and the first one that is not nearly black or without contrast is used.
+func (worker *FfmpegWorker) EncodeVideoThumbnail(inputPath string, outputPath string, probeData *ffprobe.ProbeData, posterTimestamp *float64) error {
+	spherical := isEquirectangularVideo(probeData)
+
+	if posterTimestamp != nil {
+		return worker.encodeVideoFrame(inputPath, outputPath, *posterTimestamp, false, spherical)
+	}
+
+	var bestCandidate string
+	var bestContrast float64 = -1
+	var lastErr error
+
+	for i, position := range posterCandidatePositions {
+		// The candidates are placed next to the output, so the chosen one can be moved into place
+		candidatePath := fmt.Sprintf("%s.candidate%d%s", outputPath, i, path.Ext(outputPath))
+		defer os.Remove(candidatePath)
+
+		offset := math.Floor(probeData.Format.DurationSeconds * position)
+		if err := worker.encodeVideoFrame(inputPath, candidatePath, offset, true, spherical); err != nil {
+			lastErr = err
+			continue
+		}
+
+		stats, err := media_utils.ReadFrameStatistics(candidatePath)
+		if err != nil {
+			lastErr = errors.Wrap(err, "read statistics of poster frame candidate")
+			continue
+		}
+
+		if stats.IsUsablePoster() {
+			bestCandidate = candidatePath
+			break
+		}
+
+		if stats.Contrast > bestContrast {
+			bestCandidate = candidatePath
+			bestContrast = stats.Contrast
+		}
+	}
+
+	if bestCandidate == "" {
+		return lastErr
+	}
+
+	if err := os.Rename(bestCandidate, outputPath); err != nil {
+		return errors.Wrap(err, "move poster frame into place")
 	}
 
 	return nil
 }
 
-func (worker *FfmpegWorker) EncodeVideoThumbnail(inputPath string, outputPath string, probeData *ffprobe.ProbeData) error {
-
-	thumbnailOffsetSeconds := fmt.Sprintf("%d", int(probeData.Format.DurationSeconds*0.25))
+// encodeVideoFrame saves a single frame of the video at the given offset in seconds,
+// if representative is true, the most representative of the following frames is used instead, using the thumbnail filter
+func (worker *FfmpegWorker) encodeVideoFrame(inputPath string, outputPath string, offsetSeconds float64, representative bool, spherical bool) error {
+	filter := "scale='min(1024,iw)':'min(1024,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2"
+	if spherical {
+		filter = equirectangularThumbnailCrop + "," + filter
+	}
+	if representative {
+		filter = "thumbnail=n=30," + filter
+	}
 
 	args := []string{
-		"-ss", thumbnailOffsetSeconds, // grab frame at time offset
+		"-ss", fmt.Sprintf("%.3f", offsetSeconds), // grab frame at time offset
 		"-i",
 		inputPath,
 		"-vframes", "1", // output one frame
 		"-an", // disable audio
-		"-vf", "scale='min(1024,iw)':'min(1024,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2",
+		"-vf", filter,
+		"-y", // overwrite the previous candidate
 		outputPath,
 	}
 
@@ -0,0 +1,134 @@
+package executable_worker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// HLSRendition describes a single quality level of an HLS stream
+type HLSRendition struct {
+	Name string
+	// ShortSide is the size of the shortest side of the video in pixels, so portrait videos get the same quality as landscape ones
+	ShortSide int
+	// VideoBitrate is the target bitrate of the video stream in kbit/s
+	VideoBitrate int
+}
+
+// HLSAudioBitrate is the bitrate of the audio stream of all renditions in kbit/s
+const HLSAudioBitrate = 128
+
+// HLSSegmentDuration is the target duration of a segment in seconds
+const HLSSegmentDuration = 6
+
+var DefaultHLSLadder = []HLSRendition{
+	{Name: "360p", ShortSide: 360, VideoBitrate: 800},
+	{Name: "720p", ShortSide: 720, VideoBitrate: 2800},
+	{Name: "1080p", ShortSide: 1080, VideoBitrate: 5000},
+}
+
+// SelectHLSRenditions returns the renditions of the ladder that does not require upscaling the video
+func SelectHLSRenditions(ladder []HLSRendition, width int, height int) []HLSRendition {
+	shortSide := width
+	if height < shortSide {
+		shortSide = height
+	}
+
+	renditions := make([]HLSRendition, 0)
+	for _, rendition := range ladder {
+		if rendition.ShortSide <= shortSide {
+			renditions = append(renditions, rendition)
+		}
+	}
+
+	return renditions
+}
+
+// PlaylistName returns the file name of the media playlist of the rendition
+func (rendition HLSRendition) PlaylistName() string {
+	return rendition.Name + ".m3u8"
+}
+
+// Bandwidth returns the peak bandwidth of the rendition in bit/s, as used by the master playlist
+func (rendition HLSRendition) Bandwidth() int {
+	return (rendition.maxBitrate() + HLSAudioBitrate) * 1000
+}
+
+func (rendition HLSRendition) maxBitrate() int {
+	return rendition.VideoBitrate * 107 / 100
+}
+
+// HLSVariant is a rendition that has been encoded, with the resulting dimensions
+type HLSVariant struct {
+	Rendition HLSRendition
+	Width     int
+	Height    int
+}
+
+// WriteHLSMasterPlaylist writes a master playlist, referencing the media playlists of the variants
+func WriteHLSMasterPlaylist(outputPath string, variants []HLSVariant) error {
+	var playlist strings.Builder
+
+	playlist.WriteString("#EXTM3U\n")
+	playlist.WriteString("#EXT-X-VERSION:3\n")
+
+	for _, variant := range variants {
+		playlist.WriteString(fmt.Sprintf("#EXT-X-STREAM-INF:BANDWIDTH=%d", variant.Rendition.Bandwidth()))
+		if variant.Width > 0 && variant.Height > 0 {
+			playlist.WriteString(fmt.Sprintf(",RESOLUTION=%dx%d", variant.Width, variant.Height))
+		}
+		playlist.WriteString("\n")
+		playlist.WriteString(variant.Rendition.PlaylistName() + "\n")
+	}
+
+	if err := os.WriteFile(outputPath, []byte(playlist.String()), 0644); err != nil {
+		return errors.Wrapf(err, "could not write HLS master playlist: %s", outputPath)
+	}
+
+	return nil
+}
+
+// EncodeHLSRendition encodes the video as a single HLS rendition,
+// the media playlist and segments are written to the output directory
+func (worker *FfmpegWorker) EncodeHLSRendition(inputPath string, outputDir string, rendition HLSRendition) error {
+	scaleFilter := fmt.Sprintf(
+		"scale=w='if(gte(iw,ih),-2,%[1]d)':h='if(gte(iw,ih),%[1]d,-2)'",
+		rendition.ShortSide,
+	)
+
+	args := []string{
+		"-i",
+		inputPath,
+		"-map", "0:v:0",
+		"-map", "0:a:0?",
+		"-vcodec", "h264",
+		"-preset", "veryfast",
+		"-b:v", fmt.Sprintf("%dk", rendition.VideoBitrate),
+		"-maxrate", fmt.Sprintf("%dk", rendition.maxBitrate()),
+		"-bufsize", fmt.Sprintf("%dk", rendition.VideoBitrate*3/2),
+		"-vf", scaleFilter,
+		// place a keyframe at the start of every segment, so all renditions switch at the same points
+		"-force_key_frames", fmt.Sprintf("expr:gte(t,n_forced*%d)", HLSSegmentDuration),
+		"-sc_threshold", "0",
+		"-acodec", "aac",
+		"-b:a", fmt.Sprintf("%dk", HLSAudioBitrate),
+		"-ac", "2",
+		"-f", "hls",
+		"-hls_time", fmt.Sprintf("%d", HLSSegmentDuration),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", path.Join(outputDir, rendition.Name+"_%04d.ts"),
+		path.Join(outputDir, rendition.PlaylistName()),
+	}
+
+	cmd := exec.Command(worker.path, args...)
+
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "encoding HLS rendition %s using: %s", rendition.Name, worker.path)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package executable_worker_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectHLSRenditions(t *testing.T) {
+	ladder := executable_worker.DefaultHLSLadder
+
+	assert.Equal(t, ladder, executable_worker.SelectHLSRenditions(ladder, 3840, 2160))
+	assert.Equal(t, ladder[:2], executable_worker.SelectHLSRenditions(ladder, 1280, 720))
+	assert.Equal(t, ladder[:2], executable_worker.SelectHLSRenditions(ladder, 720, 1280), "portrait videos use the shortest side")
+	assert.Empty(t, executable_worker.SelectHLSRenditions(ladder, 320, 240))
+}
+
+func TestWriteHLSMasterPlaylist(t *testing.T) {
+	playlistPath := path.Join(t.TempDir(), "master.m3u8")
+
+	variants := []executable_worker.HLSVariant{
+		{Rendition: executable_worker.DefaultHLSLadder[0], Width: 640, Height: 360},
+		{Rendition: executable_worker.DefaultHLSLadder[1]},
+	}
+
+	assert.NoError(t, executable_worker.WriteHLSMasterPlaylist(playlistPath, variants))
+
+	playlist, err := os.ReadFile(playlistPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "#EXTM3U\n"+
+		"#EXT-X-VERSION:3\n"+
+		"#EXT-X-STREAM-INF:BANDWIDTH=984000,RESOLUTION=640x360\n"+
+		"360p.m3u8\n"+
+		"#EXT-X-STREAM-INF:BANDWIDTH=3124000\n"+
+		"720p.m3u8\n", string(playlist))
+}
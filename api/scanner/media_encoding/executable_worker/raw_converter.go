@@ -0,0 +1,81 @@
+package executable_worker
+
+import (
+	"log"
+	"strings"
+
+	"github.com/photoview/photoview/api/utils"
+)
+
+// RawConverter is a backend able to develop a RAW file into a JPEG image
+type RawConverter interface {
+	Name() string
+	IsInstalled() bool
+	EncodeJpeg(inputPath string, outputPath string, jpegQuality int) error
+}
+
+// RawConverters holds the installed RAW converters, in the order they should be tried
+var RawConverters []RawConverter = nil
+
+// DefaultRawConverterOrder is used when the preference order is not configured
+var DefaultRawConverterOrder = []string{"darktable", "rawtherapee", "dcraw", "embedded-preview"}
+
+var rawConverterFactories = map[string]func() RawConverter{
+	"darktable":        func() RawConverter { return newDarktableWorker() },
+	"rawtherapee":      func() RawConverter { return newRawTherapeeWorker() },
+	"dcraw":            func() RawConverter { return newDcrawWorker() },
+	"embedded-preview": func() RawConverter { return newRawPreviewWorker() },
+}
+
+// HasRawConverter returns true if at least one RAW converter is installed
+func HasRawConverter() bool {
+	return len(RawConverters) > 0
+}
+
+// RawVersionIsPrimary returns true when the previews of RAW+JPEG pairs are generated from the RAW file,
+// which is only the case if configured so and a RAW converter is installed
+func RawVersionIsPrimary() bool {
+	return utils.RawIsPrimaryVersion() && HasRawConverter()
+}
+
+func initializeRawConverters() []RawConverter {
+	converters := make([]RawConverter, 0)
+
+	for _, name := range ParseRawConverterOrder(utils.EnvRawConverters.GetValue()) {
+		factory, found := rawConverterFactories[name]
+		if !found {
+			log.Printf("WARN: unknown RAW converter in %s: %s\n", utils.EnvRawConverters.GetName(), name)
+			continue
+		}
+
+		// The factories return typed nil pointers for missing converters, so ask the converter itself
+		if converter := factory(); converter.IsInstalled() {
+			converters = append(converters, converter)
+		}
+	}
+
+	return converters
+}
+
+// ParseRawConverterOrder parses a comma separated list of RAW converter names,
+// and falls back to the default order if the list is empty.
+func ParseRawConverterOrder(value string) []string {
+	order := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		order = append(order, name)
+	}
+
+	if len(order) == 0 {
+		return DefaultRawConverterOrder
+	}
+
+	return order
+}
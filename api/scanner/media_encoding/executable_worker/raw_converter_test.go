@@ -0,0 +1,35 @@
+package executable_worker_test
+
+import (
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/photoview/photoview/api/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRawConverterOrder(t *testing.T) {
+	assert.Equal(t, executable_worker.DefaultRawConverterOrder, executable_worker.ParseRawConverterOrder(""))
+	assert.Equal(t, executable_worker.DefaultRawConverterOrder, executable_worker.ParseRawConverterOrder(" , "))
+
+	assert.Equal(t,
+		[]string{"rawtherapee", "embedded-preview", "darktable"},
+		executable_worker.ParseRawConverterOrder("RawTherapee, embedded-preview,,darktable,rawtherapee"),
+	)
+}
+
+func TestRawVersionIsPrimary(t *testing.T) {
+	installedConverters := executable_worker.RawConverters
+	defer func() { executable_worker.RawConverters = installedConverters }()
+
+	t.Setenv(string(utils.EnvRawPrimaryVersion), "raw")
+
+	executable_worker.RawConverters = []executable_worker.RawConverter{}
+	assert.False(t, executable_worker.RawVersionIsPrimary(), "the JPEG version is used when no RAW converter is installed")
+
+	executable_worker.RawConverters = []executable_worker.RawConverter{&executable_worker.DarktableWorker{}}
+	assert.True(t, executable_worker.RawVersionIsPrimary())
+
+	t.Setenv(string(utils.EnvRawPrimaryVersion), "")
+	assert.False(t, executable_worker.RawVersionIsPrimary())
+}
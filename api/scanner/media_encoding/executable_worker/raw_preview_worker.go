@@ -0,0 +1,163 @@
+package executable_worker
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/pkg/errors"
+	"github.com/xor-gate/goexif2/exif"
+)
+
+// RawPreviewWorker converts RAW files by extracting the full size JPEG preview embedded by the camera.
+// It uses exiftool when present, and falls back to searching the file for JPEG streams otherwise.
+type RawPreviewWorker struct {
+	exiftoolPath *string
+}
+
+func newRawPreviewWorker() *RawPreviewWorker {
+	path, err := exec.LookPath("exiftool")
+	if err != nil {
+		log.Println("Found RAW preview extractor: internal")
+		return &RawPreviewWorker{}
+	}
+
+	log.Println("Found RAW preview extractor: exiftool")
+	return &RawPreviewWorker{
+		exiftoolPath: &path,
+	}
+}
+
+func (worker *RawPreviewWorker) Name() string {
+	return "embedded-preview"
+}
+
+func (worker *RawPreviewWorker) IsInstalled() bool {
+	return worker != nil
+}
+
+// EncodeJpeg writes the largest embedded preview of the RAW file to the output path,
+// rotated according to the orientation of the RAW file.
+func (worker *RawPreviewWorker) EncodeJpeg(inputPath string, outputPath string, jpegQuality int) error {
+	preview, err := worker.extractPreview(inputPath)
+	if err != nil {
+		return err
+	}
+
+	orientation := worker.readOrientation(inputPath)
+	if orientation <= 1 {
+		if err := os.WriteFile(outputPath, preview, 0644); err != nil {
+			return errors.Wrapf(err, "could not write RAW preview: %s", outputPath)
+		}
+
+		return nil
+	}
+
+	previewImage, err := jpeg.Decode(bytes.NewReader(preview))
+	if err != nil {
+		return errors.Wrapf(err, "decode embedded RAW preview (%s)", inputPath)
+	}
+
+	outputFile, err := os.Create(outputPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not create file: %s", outputPath)
+	}
+	defer outputFile.Close()
+
+	return jpeg.Encode(outputFile, applyOrientation(previewImage, orientation), &jpeg.Options{Quality: jpegQuality})
+}
+
+func (worker *RawPreviewWorker) extractPreview(inputPath string) ([]byte, error) {
+	if worker.exiftoolPath != nil {
+		for _, tag := range []string{"-JpgFromRaw", "-PreviewImage"} {
+			preview, err := exec.Command(*worker.exiftoolPath, "-b", tag, inputPath).Output()
+			if err == nil && len(preview) > 0 {
+				return preview, nil
+			}
+		}
+	}
+
+	data, err := os.ReadFile(inputPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read RAW file (%s)", inputPath)
+	}
+
+	preview := media_utils.FindLargestEmbeddedJpeg(data)
+	if preview == nil {
+		return nil, errors.Errorf("no embedded preview found in RAW file (%s)", inputPath)
+	}
+
+	return preview, nil
+}
+
+// readOrientation returns the EXIF orientation of the RAW file, or 0 if it could not be read
+func (worker *RawPreviewWorker) readOrientation(inputPath string) (orientation int) {
+	if worker.exiftoolPath != nil {
+		output, err := exec.Command(*worker.exiftoolPath, "-s3", "-n", "-Orientation", inputPath).Output()
+		if err == nil {
+			if value, err := strconv.Atoi(strings.TrimSpace(string(output))); err == nil {
+				return value
+			}
+		}
+	}
+
+	file, err := os.Open(inputPath)
+	if err != nil {
+		return 0
+	}
+	defer file.Close()
+
+	// Recover if exif.Decode panics
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("Recovered from panic: Exif decoding: %s\n", err)
+			orientation = 0
+		}
+	}()
+
+	exifTags, err := exif.Decode(file)
+	if err != nil {
+		return 0
+	}
+
+	tag, err := exifTags.Get(exif.Orientation)
+	if err != nil {
+		return 0
+	}
+
+	orientation, err = tag.Int(0)
+	if err != nil {
+		return 0
+	}
+
+	return orientation
+}
+
+// applyOrientation transforms the image the same way as an EXIF orientation value describes
+func applyOrientation(img image.Image, orientation int) image.Image {
+	switch orientation {
+	case 2:
+		return imaging.FlipH(img)
+	case 3:
+		return imaging.Rotate180(img)
+	case 4:
+		return imaging.FlipV(img)
+	case 5:
+		return imaging.Transpose(img)
+	case 6:
+		return imaging.Rotate270(img)
+	case 7:
+		return imaging.Transverse(img)
+	case 8:
+		return imaging.Rotate90(img)
+	}
+
+	return img
+}
@@ -0,0 +1,73 @@
+package executable_worker
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+)
+
+type RawTherapeeWorker struct {
+	path    string
+	profile *string
+}
+
+func newRawTherapeeWorker() *RawTherapeeWorker {
+	if utils.EnvDisableRawProcessing.GetBool() {
+		log.Printf("Executable worker disabled (%s=1): rawtherapee\n", utils.EnvDisableRawProcessing.GetName())
+		return nil
+	}
+
+	path, err := exec.LookPath("rawtherapee-cli")
+	if err != nil {
+		log.Println("Executable worker not found: rawtherapee")
+		return nil
+	}
+
+	log.Printf("Found executable worker: rawtherapee (%s)\n", path)
+
+	worker := RawTherapeeWorker{
+		path: path,
+	}
+
+	if profile := utils.EnvRawTherapeeProfile.GetValue(); profile != "" {
+		worker.profile = &profile
+	}
+
+	return &worker
+}
+
+func (worker *RawTherapeeWorker) Name() string {
+	return "rawtherapee"
+}
+
+func (worker *RawTherapeeWorker) IsInstalled() bool {
+	return worker != nil
+}
+
+func (worker *RawTherapeeWorker) EncodeJpeg(inputPath string, outputPath string, jpegQuality int) error {
+	args := []string{
+		"-o", outputPath,
+		fmt.Sprintf("-j%d", jpegQuality),
+		"-Y",
+	}
+
+	if worker.profile != nil {
+		args = append(args, "-p", *worker.profile)
+	} else {
+		args = append(args, "-d")
+	}
+
+	// the input file must be the last argument
+	args = append(args, "-c", inputPath)
+
+	cmd := exec.Command(worker.path, args...)
+
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "encoding image using: %s %v", worker.path, args)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package executable_worker
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/vansante/go-ffprobe.v2"
+)
+
+// VideoPreviewSegments is the number of segments an animated video preview consists of
+const VideoPreviewSegments = 5
+
+// VideoPreviewSegmentLength is the duration of each preview segment in seconds
+const VideoPreviewSegmentLength = 1.0
+
+// VideoPreviewSize is the maximum width and height of the preview
+const VideoPreviewSize = 320
+
+// VideoPreviewSegmentOffsets returns the start time of each segment of the preview, evenly spaced over the video.
+// Videos too short to hold the segments apart are previewed from the start instead.
+func VideoPreviewSegmentOffsets(durationSeconds float64) []float64 {
+	if durationSeconds <= 2*VideoPreviewSegments*VideoPreviewSegmentLength {
+		return []float64{0}
+	}
+
+	// Place each segment in the middle of its part of the video, skipping the very start and end
+	interval := durationSeconds / VideoPreviewSegments
+	offsets := make([]float64, VideoPreviewSegments)
+	for i := range offsets {
+		offsets[i] = interval*float64(i) + (interval-VideoPreviewSegmentLength)/2
+	}
+
+	return offsets
+}
+
+// EncodeVideoPreview generates a short, muted and downscaled mp4 video,
+// combining several segments of the video, to be played when hovering over the video
+func (worker *FfmpegWorker) EncodeVideoPreview(inputPath string, outputPath string, probeData *ffprobe.ProbeData) error {
+	offsets := VideoPreviewSegmentOffsets(probeData.Format.DurationSeconds)
+
+	segmentLength := VideoPreviewSegmentLength
+	if len(offsets) == 1 {
+		segmentLength = VideoPreviewSegments * VideoPreviewSegmentLength
+	}
+
+	args := make([]string, 0)
+	filterInputs := make([]string, 0, len(offsets))
+	for i, offset := range offsets {
+		args = append(args,
+			"-ss", fmt.Sprintf("%.3f", offset),
+			"-t", fmt.Sprintf("%.3f", segmentLength),
+			"-i", inputPath,
+		)
+		filterInputs = append(filterInputs, fmt.Sprintf("[%d:v:0]", i))
+	}
+
+	filter := fmt.Sprintf(
+		"%sconcat=n=%d:v=1:a=0,scale='min(%[3]d,iw)':'min(%[3]d,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2[preview]",
+		strings.Join(filterInputs, ""), len(offsets), VideoPreviewSize,
+	)
+
+	args = append(args,
+		"-filter_complex", filter,
+		"-map", "[preview]",
+		"-an", // disable audio
+		"-vcodec", "h264",
+		"-pix_fmt", "yuv420p",
+		"-movflags", "+faststart",
+		outputPath,
+	)
+
+	cmd := exec.Command(worker.path, args...)
+
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "encoding video preview using: %s", worker.path)
+	}
+
+	return nil
+}
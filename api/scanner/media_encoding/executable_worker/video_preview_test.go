@@ -0,0 +1,14 @@
+package executable_worker_test
+
+import (
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVideoPreviewSegmentOffsets(t *testing.T) {
+	assert.Equal(t, []float64{0}, executable_worker.VideoPreviewSegmentOffsets(4))
+	assert.Equal(t, []float64{0}, executable_worker.VideoPreviewSegmentOffsets(10))
+	assert.Equal(t, []float64{9.5, 29.5, 49.5, 69.5, 89.5}, executable_worker.VideoPreviewSegmentOffsets(100))
+}
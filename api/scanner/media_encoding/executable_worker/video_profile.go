@@ -0,0 +1,316 @@
+package executable_worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+	"gopkg.in/vansante/go-ffprobe.v2"
+)
+
+type VideoCodec string
+
+const (
+	VideoCodecH264 VideoCodec = "h264"
+	VideoCodecHEVC VideoCodec = "hevc"
+	VideoCodecVP9  VideoCodec = "vp9"
+	VideoCodecAV1  VideoCodec = "av1"
+)
+
+// defaultVideoEncoders maps the codecs to the ffmpeg encoder used, unless the profile overrides it.
+// H.264 is left to the default encoder of ffmpeg, as videos were encoded before profiles were introduced.
+var defaultVideoEncoders = map[VideoCodec]string{
+	VideoCodecH264: "h264",
+	VideoCodecHEVC: "libx265",
+	VideoCodecVP9:  "libvpx-vp9",
+	VideoCodecAV1:  "libsvtav1",
+}
+
+// VideoProfile describes how videos are transcoded to a web compatible format
+type VideoProfile struct {
+	Name       string     `json:"name"`
+	VideoCodec VideoCodec `json:"videoCodec"`
+	// Encoder overrides the ffmpeg encoder used for the codec, for example `h264_vaapi`
+	Encoder string `json:"encoder,omitempty"`
+	// MaxSize is the maximum width and height of the transcoded video, or 0 to keep the original size
+	MaxSize int `json:"maxSize,omitempty"`
+	// CRF enables constant quality encoding, and takes precedence over the bitrate
+	CRF *int `json:"crf,omitempty"`
+	// VideoBitrate is the target bitrate of the video in kbit/s
+	VideoBitrate int    `json:"videoBitrate,omitempty"`
+	Preset       string `json:"preset,omitempty"`
+	AudioCodec   string `json:"audioCodec,omitempty"`
+	// AudioBitrate is the bitrate of the audio in kbit/s
+	AudioBitrate  int `json:"audioBitrate,omitempty"`
+	AudioChannels int `json:"audioChannels,omitempty"`
+	// TranscodeIf decides which web compatible videos are transcoded as well,
+	// videos that are not web compatible are always transcoded
+	TranscodeIf VideoTranscodeRules `json:"transcodeIf"`
+}
+
+// VideoTranscodeRules are the conditions under which a web compatible video is transcoded,
+// the video is transcoded if any of the configured conditions are met.
+type VideoTranscodeRules struct {
+	// MaxBitrate transcodes videos with a higher bitrate in kbit/s
+	MaxBitrate int `json:"maxBitrate,omitempty"`
+	// AllowedCodecs transcodes videos with a codec not in the list
+	AllowedCodecs []string `json:"allowedCodecs,omitempty"`
+	// MaxSize transcodes videos with a width or height larger than this
+	MaxSize int `json:"maxSize,omitempty"`
+}
+
+// DefaultVideoProfile matches how videos were transcoded before profiles were introduced
+var DefaultVideoProfile = VideoProfile{
+	Name:       "default",
+	VideoCodec: VideoCodecH264,
+	MaxSize:    1080,
+	AudioCodec: "aac",
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+var builtinVideoProfiles = []VideoProfile{
+	DefaultVideoProfile,
+	{
+		Name:         "hevc",
+		VideoCodec:   VideoCodecHEVC,
+		MaxSize:      1080,
+		CRF:          intPtr(28),
+		Preset:       "medium",
+		AudioCodec:   "aac",
+		AudioBitrate: 128,
+	},
+	{
+		Name:         "vp9",
+		VideoCodec:   VideoCodecVP9,
+		MaxSize:      1080,
+		CRF:          intPtr(33),
+		AudioCodec:   "libopus",
+		AudioBitrate: 96,
+	},
+	{
+		Name:         "av1",
+		VideoCodec:   VideoCodecAV1,
+		MaxSize:      1080,
+		CRF:          intPtr(35),
+		Preset:       "8",
+		AudioCodec:   "libopus",
+		AudioBitrate: 96,
+	},
+}
+
+// ActiveVideoProfile is the profile used to transcode videos, selected by the `PHOTOVIEW_VIDEO_PROFILE` environment variable
+var ActiveVideoProfile *VideoProfile = &DefaultVideoProfile
+
+// LoadVideoProfiles returns the built-in profiles, together with the profiles defined in the given JSON file.
+// Profiles from the file replace built-in profiles with the same name.
+func LoadVideoProfiles(profilesPath string) (map[string]*VideoProfile, error) {
+	profiles := make(map[string]*VideoProfile, len(builtinVideoProfiles))
+	for i := range builtinVideoProfiles {
+		profile := builtinVideoProfiles[i]
+		profiles[profile.Name] = &profile
+	}
+
+	if profilesPath == "" {
+		return profiles, nil
+	}
+
+	data, err := os.ReadFile(profilesPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read video profiles (%s)", profilesPath)
+	}
+
+	var customProfiles []VideoProfile
+	if err := json.Unmarshal(data, &customProfiles); err != nil {
+		return nil, errors.Wrapf(err, "parse video profiles (%s)", profilesPath)
+	}
+
+	for i := range customProfiles {
+		profile := customProfiles[i]
+		if profile.Name == "" {
+			return nil, errors.Errorf("video profile without a name (%s)", profilesPath)
+		}
+
+		if _, found := defaultVideoEncoders[profile.VideoCodec]; !found && profile.Encoder == "" {
+			return nil, errors.Errorf("video profile %s has unsupported codec: %s", profile.Name, profile.VideoCodec)
+		}
+
+		profiles[profile.Name] = &profile
+	}
+
+	return profiles, nil
+}
+
+func loadActiveVideoProfile() *VideoProfile {
+	profiles, err := LoadVideoProfiles(utils.EnvVideoProfilesPath.GetValue())
+	if err != nil {
+		log.Printf("WARN: could not load video profiles, using the default profile: %s\n", err)
+		return &DefaultVideoProfile
+	}
+
+	name := utils.EnvVideoProfile.GetValue()
+	if name == "" {
+		name = DefaultVideoProfile.Name
+	}
+
+	profile, found := profiles[name]
+	if !found {
+		log.Printf("WARN: video profile not found (%s=%s), using the default profile\n", utils.EnvVideoProfile.GetName(), name)
+		return &DefaultVideoProfile
+	}
+
+	log.Printf("Using video profile: %s\n", profile.Name)
+	return profile
+}
+
+// NeedsTranscoding determines if a video should be transcoded, given the metadata of the original video
+func (profile *VideoProfile) NeedsTranscoding(webCompatible bool, probeData *ffprobe.ProbeData) bool {
+	var codec string
+	var width, height int
+	if stream := probeData.FirstVideoStream(); stream != nil {
+		codec = stream.CodecName
+		width, height = stream.Width, stream.Height
+	}
+
+	var bitrate int64
+	if probeData.Format != nil {
+		bitrate, _ = strconv.ParseInt(probeData.Format.BitRate, 10, 64)
+	}
+
+	return profile.TranscodeIf.matches(webCompatible, codec, bitrate, width, height)
+}
+
+func (rules VideoTranscodeRules) matches(webCompatible bool, codec string, bitrate int64, width int, height int) bool {
+	if !webCompatible {
+		return true
+	}
+
+	if rules.MaxBitrate > 0 && bitrate > int64(rules.MaxBitrate)*1000 {
+		return true
+	}
+
+	if len(rules.AllowedCodecs) > 0 {
+		allowed := false
+		for _, allowedCodec := range rules.AllowedCodecs {
+			if strings.EqualFold(allowedCodec, codec) {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return true
+		}
+	}
+
+	if rules.MaxSize > 0 && (width > rules.MaxSize || height > rules.MaxSize) {
+		return true
+	}
+
+	return false
+}
+
+// EncodingParameters returns the settings of the profile that affects the transcoded video,
+// such that videos can be re-encoded when they change.
+func (profile *VideoProfile) EncodingParameters() string {
+	parameters := *profile
+	parameters.Name = ""
+	parameters.TranscodeIf = VideoTranscodeRules{}
+
+	data, err := json.Marshal(parameters)
+	if err != nil {
+		// Can not happen, as the profile only consists of plain values
+		panic(err)
+	}
+
+	return string(data)
+}
+
+func (profile *VideoProfile) encoder() string {
+	if profile.Encoder != "" {
+		return profile.Encoder
+	}
+
+	return defaultVideoEncoders[profile.VideoCodec]
+}
+
+func (profile *VideoProfile) ffmpegArgs(inputPath string, outputPath string) []string {
+	args := []string{
+		"-i",
+		inputPath,
+		"-vcodec", profile.encoder(),
+	}
+
+	if profile.CRF != nil {
+		args = append(args, "-crf", strconv.Itoa(*profile.CRF))
+
+		// VP9 only uses constant quality mode when the bitrate is set to zero
+		if profile.VideoCodec == VideoCodecVP9 {
+			args = append(args, "-b:v", "0")
+		}
+	} else if profile.VideoBitrate > 0 {
+		args = append(args, "-b:v", fmt.Sprintf("%dk", profile.VideoBitrate))
+	}
+
+	if profile.Preset != "" {
+		if profile.VideoCodec == VideoCodecVP9 {
+			args = append(args, "-deadline", "good", "-cpu-used", profile.Preset)
+		} else {
+			args = append(args, "-preset", profile.Preset)
+		}
+	}
+
+	// Safari only plays HEVC in mp4 files with the hvc1 tag
+	if profile.VideoCodec == VideoCodecHEVC {
+		args = append(args, "-tag:v", "hvc1")
+	}
+
+	if profile.MaxSize > 0 {
+		args = append(args, "-vf", fmt.Sprintf(
+			"scale='min(%[1]d,iw)':'min(%[1]d,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2",
+			profile.MaxSize,
+		))
+	}
+
+	audioCodec := profile.AudioCodec
+	if audioCodec == "" {
+		audioCodec = "aac"
+	}
+	args = append(args, "-acodec", audioCodec)
+
+	if profile.AudioBitrate > 0 {
+		args = append(args, "-b:a", fmt.Sprintf("%dk", profile.AudioBitrate))
+	}
+
+	if profile.AudioChannels > 0 {
+		args = append(args, "-ac", strconv.Itoa(profile.AudioChannels))
+	}
+
+	args = append(args,
+		"-movflags", "+faststart+use_metadata_tags",
+		outputPath,
+	)
+
+	return args
+}
+
+// EncodeVideo transcodes the video to an mp4 file using the given profile
+func (worker *FfmpegWorker) EncodeVideo(inputPath string, outputPath string, profile *VideoProfile) error {
+	args := profile.ffmpegArgs(inputPath, outputPath)
+
+	cmd := exec.Command(worker.path, args...)
+
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "encoding video using: %s %v", worker.path, args)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package executable_worker_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/vansante/go-ffprobe.v2"
+)
+
+func TestVideoProfileNeedsTranscoding(t *testing.T) {
+	probeData := &ffprobe.ProbeData{
+		Format: &ffprobe.Format{BitRate: "100000000"},
+		Streams: []*ffprobe.Stream{
+			{CodecType: "video", CodecName: "hevc", Width: 3840, Height: 2160},
+		},
+	}
+
+	profile := executable_worker.DefaultVideoProfile
+	assert.False(t, profile.NeedsTranscoding(true, probeData))
+	assert.True(t, profile.NeedsTranscoding(false, probeData))
+
+	profile.TranscodeIf = executable_worker.VideoTranscodeRules{MaxBitrate: 200000}
+	assert.False(t, profile.NeedsTranscoding(true, probeData))
+
+	profile.TranscodeIf = executable_worker.VideoTranscodeRules{MaxBitrate: 20000}
+	assert.True(t, profile.NeedsTranscoding(true, probeData))
+
+	profile.TranscodeIf = executable_worker.VideoTranscodeRules{AllowedCodecs: []string{"H264", "HEVC"}}
+	assert.False(t, profile.NeedsTranscoding(true, probeData))
+
+	profile.TranscodeIf = executable_worker.VideoTranscodeRules{AllowedCodecs: []string{"h264"}}
+	assert.True(t, profile.NeedsTranscoding(true, probeData))
+
+	profile.TranscodeIf = executable_worker.VideoTranscodeRules{MaxSize: 1920}
+	assert.True(t, profile.NeedsTranscoding(true, probeData))
+}
+
+func TestVideoProfileEncodingParameters(t *testing.T) {
+	profile := executable_worker.DefaultVideoProfile
+	parameters := profile.EncodingParameters()
+
+	profile.Name = "renamed"
+	profile.TranscodeIf.MaxBitrate = 8000
+	assert.Equal(t, parameters, profile.EncodingParameters(), "name and rules do not affect the encoded video")
+
+	profile.MaxSize = 720
+	assert.NotEqual(t, parameters, profile.EncodingParameters())
+}
+
+func TestLoadVideoProfiles(t *testing.T) {
+	profiles, err := executable_worker.LoadVideoProfiles("")
+	assert.NoError(t, err)
+	assert.Contains(t, profiles, "default")
+	assert.Contains(t, profiles, "hevc")
+
+	profilesPath := path.Join(t.TempDir(), "profiles.json")
+	assert.NoError(t, os.WriteFile(profilesPath, []byte(`[
+		{"name": "default", "videoCodec": "h264", "maxSize": 720, "crf": 26},
+		{"name": "phones", "videoCodec": "hevc", "transcodeIf": {"maxBitrate": 20000, "allowedCodecs": ["h264"]}}
+	]`), 0644))
+
+	profiles, err = executable_worker.LoadVideoProfiles(profilesPath)
+	assert.NoError(t, err)
+	assert.Equal(t, 720, profiles["default"].MaxSize)
+	assert.Equal(t, 26, *profiles["default"].CRF)
+	assert.Equal(t, []string{"h264"}, profiles["phones"].TranscodeIf.AllowedCodecs)
+
+	assert.NoError(t, os.WriteFile(profilesPath, []byte(`[{"name": "mpeg2", "videoCodec": "mpeg2"}]`), 0644))
+	_, err = executable_worker.LoadVideoProfiles(profilesPath)
+	assert.Error(t, err)
+}
@@ -0,0 +1,503 @@
+package media_utils
+
+import (
+	"encoding/binary"
+	"image"
+	"math"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedColorProfile is returned for colour profiles that can not be converted to sRGB,
+// such as CMYK, grayscale and LUT based profiles.
+var ErrUnsupportedColorProfile = errors.New("unsupported color profile")
+
+type matrix3 [3][3]float64
+
+func (m matrix3) mul(other matrix3) matrix3 {
+	var result matrix3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				result[i][j] += m[i][k] * other[k][j]
+			}
+		}
+	}
+	return result
+}
+
+func (m matrix3) apply(v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+func (m matrix3) inverse() matrix3 {
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+
+	return matrix3{
+		{
+			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / det,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) / det,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) / det,
+		},
+		{
+			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / det,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) / det,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) / det,
+		},
+		{
+			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / det,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) / det,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) / det,
+		},
+	}
+}
+
+// toneCurve converts an encoded channel value to linear light, both in the range 0 to 1
+type toneCurve func(value float64) float64
+
+func srgbToLinear(value float64) float64 {
+	if value <= 0.04045 {
+		return value / 12.92
+	}
+	return math.Pow((value+0.055)/1.055, 2.4)
+}
+
+func linearToSrgb(value float64) float64 {
+	if value <= 0.0031308 {
+		return value * 12.92
+	}
+	return 1.055*math.Pow(value, 1/2.4) - 0.055
+}
+
+// ColorProfile is an RGB colour profile described by a matrix and tone curves,
+// which covers the profiles used by cameras and phones, such as Adobe RGB, Display P3 and ProPhoto RGB.
+type ColorProfile struct {
+	// toXYZ converts linear RGB values to the D50 based XYZ profile connection space
+	toXYZ  matrix3
+	curves [3]toneCurve
+}
+
+var (
+	d50White = [3]float64{0.96422, 1.0, 0.82521}
+	d65White = [3]float64{0.95047, 1.0, 1.08883}
+
+	bradford = matrix3{
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	}
+)
+
+// primariesToXYZ returns the matrix converting linear RGB with the given primaries and D65 white point,
+// to XYZ adapted to the D50 white point of the profile connection space
+func primariesToXYZ(red, green, blue [2]float64) matrix3 {
+	primaries := matrix3{}
+	for column, xy := range [3][2]float64{red, green, blue} {
+		primaries[0][column] = xy[0] / xy[1]
+		primaries[1][column] = 1
+		primaries[2][column] = (1 - xy[0] - xy[1]) / xy[1]
+	}
+
+	scale := primaries.inverse().apply(d65White)
+	for row := 0; row < 3; row++ {
+		for column := 0; column < 3; column++ {
+			primaries[row][column] *= scale[column]
+		}
+	}
+
+	sourceCone := bradford.apply(d65White)
+	targetCone := bradford.apply(d50White)
+	coneScale := matrix3{
+		{targetCone[0] / sourceCone[0], 0, 0},
+		{0, targetCone[1] / sourceCone[1], 0},
+		{0, 0, targetCone[2] / sourceCone[2]},
+	}
+
+	adaptation := bradford.inverse().mul(coneScale).mul(bradford)
+	return adaptation.mul(primaries)
+}
+
+var srgbToXYZ = primariesToXYZ([2]float64{0.64, 0.33}, [2]float64{0.30, 0.60}, [2]float64{0.15, 0.06})
+
+// IsSRGB returns true if the profile is close enough to sRGB that converting the pixels makes no visible difference
+func (profile *ColorProfile) IsSRGB() bool {
+	for row := 0; row < 3; row++ {
+		for column := 0; column < 3; column++ {
+			if math.Abs(profile.toXYZ[row][column]-srgbToXYZ[row][column]) > 0.003 {
+				return false
+			}
+		}
+	}
+
+	for _, curve := range profile.curves {
+		for value := 0.1; value < 1; value += 0.1 {
+			if math.Abs(linearToSrgb(curve(value))-value) > 0.02 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ConvertToSRGB converts the pixels of the image from the given colour profile to sRGB
+func ConvertToSRGB(img image.Image, profile *ColorProfile) *image.NRGBA {
+	output := imaging.Clone(img)
+
+	var linear [3][256]float64
+	for channel, curve := range profile.curves {
+		for value := 0; value < 256; value++ {
+			linear[channel][value] = curve(float64(value) / 255)
+		}
+	}
+
+	const encodeSteps = 16384
+	var encode [encodeSteps + 1]uint8
+	for i := range encode {
+		encode[i] = uint8(math.Round(linearToSrgb(float64(i)/encodeSteps) * 255))
+	}
+
+	conversion := srgbToXYZ.inverse().mul(profile.toXYZ)
+
+	for i := 0; i+3 < len(output.Pix); i += 4 {
+		pixel := output.Pix[i : i+3 : i+3]
+		converted := conversion.apply([3]float64{
+			linear[0][pixel[0]],
+			linear[1][pixel[1]],
+			linear[2][pixel[2]],
+		})
+
+		for channel, value := range converted {
+			value = math.Min(math.Max(value, 0), 1)
+			pixel[channel] = encode[int(value*encodeSteps+0.5)]
+		}
+	}
+
+	return output
+}
+
+// ParseICCProfile parses an ICC profile, only RGB profiles based on a matrix and tone curves are supported
+func ParseICCProfile(data []byte) (*ColorProfile, error) {
+	if len(data) < 132 || string(data[36:40]) != "acsp" {
+		return nil, errors.New("invalid ICC profile")
+	}
+
+	if string(data[16:20]) != "RGB " || string(data[20:24]) != "XYZ " {
+		return nil, errors.Wrapf(ErrUnsupportedColorProfile, "color space %q", string(data[16:20]))
+	}
+
+	tags := make(map[string][]byte)
+	tagCount := int(binary.BigEndian.Uint32(data[128:132]))
+	for i := 0; i < tagCount; i++ {
+		entry := 132 + i*12
+		if entry+12 > len(data) {
+			return nil, errors.New("invalid ICC profile: tag table out of bounds")
+		}
+
+		signature := string(data[entry : entry+4])
+		offset := int(binary.BigEndian.Uint32(data[entry+4 : entry+8]))
+		size := int(binary.BigEndian.Uint32(data[entry+8 : entry+12]))
+		if offset < 0 || size < 0 || offset+size > len(data) {
+			return nil, errors.Errorf("invalid ICC profile: tag %s out of bounds", signature)
+		}
+
+		tags[signature] = data[offset : offset+size]
+	}
+
+	profile := ColorProfile{}
+
+	for column, signature := range []string{"rXYZ", "gXYZ", "bXYZ"} {
+		tag, found := tags[signature]
+		if !found {
+			return nil, errors.Wrapf(ErrUnsupportedColorProfile, "missing %s tag", signature)
+		}
+
+		if len(tag) < 20 || string(tag[0:4]) != "XYZ " {
+			return nil, errors.Errorf("invalid ICC profile: bad %s tag", signature)
+		}
+
+		for row := 0; row < 3; row++ {
+			profile.toXYZ[row][column] = s15Fixed16(tag[8+row*4:])
+		}
+	}
+
+	for channel, signature := range []string{"rTRC", "gTRC", "bTRC"} {
+		tag, found := tags[signature]
+		if !found {
+			return nil, errors.Wrapf(ErrUnsupportedColorProfile, "missing %s tag", signature)
+		}
+
+		curve, err := parseToneCurve(tag)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s tag", signature)
+		}
+
+		profile.curves[channel] = curve
+	}
+
+	return &profile, nil
+}
+
+func s15Fixed16(data []byte) float64 {
+	return float64(int32(binary.BigEndian.Uint32(data))) / 65536
+}
+
+func parseToneCurve(tag []byte) (toneCurve, error) {
+	if len(tag) < 12 {
+		return nil, errors.New("invalid tone curve")
+	}
+
+	switch string(tag[0:4]) {
+	case "curv":
+		count := int(binary.BigEndian.Uint32(tag[8:12]))
+		if len(tag) < 12+count*2 {
+			return nil, errors.New("invalid tone curve: table out of bounds")
+		}
+
+		switch count {
+		case 0:
+			return func(value float64) float64 { return value }, nil
+		case 1:
+			gamma := float64(binary.BigEndian.Uint16(tag[12:14])) / 256
+			return func(value float64) float64 { return math.Pow(value, gamma) }, nil
+		}
+
+		table := make([]float64, count)
+		for i := range table {
+			table[i] = float64(binary.BigEndian.Uint16(tag[12+i*2:])) / 65535
+		}
+
+		return func(value float64) float64 {
+			position := value * float64(count-1)
+			index := int(position)
+			if index >= count-1 {
+				return table[count-1]
+			}
+			fraction := position - float64(index)
+			return table[index]*(1-fraction) + table[index+1]*fraction
+		}, nil
+
+	case "para":
+		functionType := binary.BigEndian.Uint16(tag[8:10])
+		parameterCounts := []int{1, 3, 4, 5, 7}
+		if int(functionType) >= len(parameterCounts) || len(tag) < 12+parameterCounts[functionType]*4 {
+			return nil, errors.Errorf("invalid parametric tone curve of type %d", functionType)
+		}
+
+		// Parameters are g, a, b, c, d, e and f in the notation of the ICC specification
+		p := make([]float64, 7)
+		for i := 0; i < parameterCounts[functionType]; i++ {
+			p[i] = s15Fixed16(tag[12+i*4:])
+		}
+		g, a, b, c, d, e, f := p[0], p[1], p[2], p[3], p[4], p[5], p[6]
+
+		switch functionType {
+		case 0:
+			return func(x float64) float64 { return math.Pow(x, g) }, nil
+		case 1:
+			return func(x float64) float64 {
+				if x >= -b/a {
+					return math.Pow(a*x+b, g)
+				}
+				return 0
+			}, nil
+		case 2:
+			return func(x float64) float64 {
+				if x >= -b/a {
+					return math.Pow(a*x+b, g) + c
+				}
+				return c
+			}, nil
+		case 3:
+			return func(x float64) float64 {
+				if x >= d {
+					return math.Pow(a*x+b, g)
+				}
+				return c * x
+			}, nil
+		default:
+			return func(x float64) float64 {
+				if x >= d {
+					return math.Pow(a*x+b, g) + e
+				}
+				return c*x + f
+			}, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrUnsupportedColorProfile, "tone curve of type %q", string(tag[0:4]))
+}
+
+// nclxPrimaries maps the colour primaries codes of ITU-T H.273 to the xy coordinates of the red, green and blue primaries
+var nclxPrimaries = map[uint16][3][2]float64{
+	1:  {{0.64, 0.33}, {0.30, 0.60}, {0.15, 0.06}},       // BT.709 and sRGB
+	9:  {{0.708, 0.292}, {0.170, 0.797}, {0.131, 0.046}}, // BT.2020
+	12: {{0.680, 0.320}, {0.265, 0.690}, {0.150, 0.060}}, // Display P3
+}
+
+// ColorProfileFromNCLX creates a colour profile from the colour primaries and transfer characteristics codes,
+// used by HEIF images that do not embed an ICC profile
+func ColorProfileFromNCLX(primariesCode uint16, transferCode uint16) (*ColorProfile, error) {
+	primaries, found := nclxPrimaries[primariesCode]
+	if !found {
+		return nil, errors.Wrapf(ErrUnsupportedColorProfile, "nclx colour primaries %d", primariesCode)
+	}
+
+	var curve toneCurve
+	switch transferCode {
+	case 1, 2, 6, 13, 14, 15:
+		// The SDR transfer characteristics are all displayed close to the sRGB curve
+		curve = srgbToLinear
+	case 8:
+		curve = func(value float64) float64 { return value }
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedColorProfile, "nclx transfer characteristics %d", transferCode)
+	}
+
+	return &ColorProfile{
+		toXYZ:  primariesToXYZ(primaries[0], primaries[1], primaries[2]),
+		curves: [3]toneCurve{curve, curve, curve},
+	}, nil
+}
+
+var (
+	srgbProfileData []byte
+	srgbProfileOnce sync.Once
+)
+
+// SRGBProfile returns an ICC version 2 profile describing sRGB, to embed in generated images
+func SRGBProfile() []byte {
+	srgbProfileOnce.Do(func() {
+		srgbProfileData = buildSRGBProfile()
+	})
+
+	return srgbProfileData
+}
+
+func buildSRGBProfile() []byte {
+	appendUint32 := func(data []byte, value uint32) []byte {
+		return binary.BigEndian.AppendUint32(data, value)
+	}
+
+	appendXYZ := func(xyz [3]float64) []byte {
+		tag := []byte("XYZ \x00\x00\x00\x00")
+		for _, value := range xyz {
+			tag = appendUint32(tag, uint32(int32(math.Round(value*65536))))
+		}
+		return tag
+	}
+
+	description := "sRGB"
+	descriptionTag := []byte("desc\x00\x00\x00\x00")
+	descriptionTag = appendUint32(descriptionTag, uint32(len(description)+1))
+	descriptionTag = append(descriptionTag, description...)
+	descriptionTag = append(descriptionTag, 0)
+	// Empty unicode and ScriptCode descriptions
+	descriptionTag = append(descriptionTag, make([]byte, 4+4+2+1+67)...)
+
+	copyrightTag := []byte("text\x00\x00\x00\x00No copyright, use freely\x00")
+
+	const curveSize = 1024
+	curveTag := []byte("curv\x00\x00\x00\x00")
+	curveTag = appendUint32(curveTag, curveSize)
+	for i := 0; i < curveSize; i++ {
+		value := srgbToLinear(float64(i) / (curveSize - 1))
+		curveTag = binary.BigEndian.AppendUint16(curveTag, uint16(math.Round(value*65535)))
+	}
+
+	type profileTag struct {
+		signature string
+		data      []byte
+	}
+
+	column := func(index int) [3]float64 {
+		return [3]float64{srgbToXYZ[0][index], srgbToXYZ[1][index], srgbToXYZ[2][index]}
+	}
+
+	tags := []profileTag{
+		{"desc", descriptionTag},
+		{"cprt", copyrightTag},
+		{"wtpt", appendXYZ(d50White)},
+		{"rXYZ", appendXYZ(column(0))},
+		{"gXYZ", appendXYZ(column(1))},
+		{"bXYZ", appendXYZ(column(2))},
+		{"rTRC", curveTag},
+		{"gTRC", curveTag},
+		{"bTRC", curveTag},
+	}
+
+	tagTable := make([]byte, 0, 4+len(tags)*12)
+	tagTable = appendUint32(tagTable, uint32(len(tags)))
+
+	tagData := make([]byte, 0)
+	offsets := make(map[string]int)
+	dataStart := 128 + 4 + len(tags)*12
+
+	for _, tag := range tags {
+		// The tone curves share their data, as is common practice for ICC profiles
+		key := string(tag.data)
+		offset, found := offsets[key]
+		if !found {
+			offset = dataStart + len(tagData)
+			offsets[key] = offset
+			tagData = append(tagData, tag.data...)
+			for len(tagData)%4 != 0 {
+				tagData = append(tagData, 0)
+			}
+		}
+
+		tagTable = append(tagTable, tag.signature...)
+		tagTable = appendUint32(tagTable, uint32(offset))
+		tagTable = appendUint32(tagTable, uint32(len(tag.data)))
+	}
+
+	header := make([]byte, 0, 128)
+	header = appendUint32(header, uint32(dataStart+len(tagData)))
+	header = append(header, "\x00\x00\x00\x00"...) // preferred CMM
+	header = appendUint32(header, 0x02100000)      // version 2.1
+	header = append(header, "mntrRGB XYZ "...)
+	header = append(header, make([]byte, 12)...) // creation date
+	header = append(header, "acsp"...)
+	header = append(header, make([]byte, 4+4+4+4+8+4)...) // platform, flags, manufacturer, model, attributes and intent
+	header = append(header, appendXYZ(d50White)[8:]...)   // illuminant
+	header = append(header, make([]byte, 128-len(header))...)
+
+	profile := append(header, tagTable...)
+	return append(profile, tagData...)
+}
+
+// EmbedJpegICCProfile inserts the ICC profile into the JPEG data, split into APP2 segments as needed
+func EmbedJpegICCProfile(jpegData []byte, profile []byte) ([]byte, error) {
+	if len(jpegData) < 2 || jpegData[0] != 0xFF || jpegData[1] != 0xD8 {
+		return nil, errors.New("invalid JPEG data")
+	}
+
+	const maxChunkSize = 65535 - 2 - 14
+	chunkCount := (len(profile) + maxChunkSize - 1) / maxChunkSize
+	if chunkCount > 255 {
+		return nil, errors.New("ICC profile too large to embed in JPEG")
+	}
+
+	output := make([]byte, 0, len(jpegData)+len(profile)+chunkCount*18)
+	output = append(output, 0xFF, 0xD8)
+
+	for i := 0; i < chunkCount; i++ {
+		chunk := profile[i*maxChunkSize : min((i+1)*maxChunkSize, len(profile))]
+
+		output = append(output, 0xFF, 0xE2)
+		output = binary.BigEndian.AppendUint16(output, uint16(2+14+len(chunk)))
+		output = append(output, "ICC_PROFILE\x00"...)
+		output = append(output, byte(i+1), byte(chunkCount))
+		output = append(output, chunk...)
+	}
+
+	return append(output, jpegData[2:]...), nil
+}
@@ -0,0 +1,255 @@
+package media_utils
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// ReadColorProfile reads the colour profile embedded in a JPEG, PNG or HEIF image.
+// It returns nil if the image has no colour profile, in which case it should be treated as sRGB.
+func ReadColorProfile(imagePath string) (*ColorProfile, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open image to read color profile (%s)", imagePath)
+	}
+	defer file.Close()
+
+	magic := make([]byte, 12)
+	if _, err := io.ReadFull(file, magic); err != nil {
+		return nil, nil
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, []byte{0xFF, 0xD8}):
+		iccData, err := readJpegICCProfile(bufio.NewReader(file))
+		if err != nil || iccData == nil {
+			return nil, err
+		}
+		return ParseICCProfile(iccData)
+	case bytes.HasPrefix(magic, []byte("\x89PNG\r\n\x1a\n")):
+		iccData, err := readPngICCProfile(bufio.NewReader(file))
+		if err != nil || iccData == nil {
+			return nil, err
+		}
+		return ParseICCProfile(iccData)
+	case string(magic[4:8]) == "ftyp":
+		return readHeifColorProfile(file)
+	}
+
+	return nil, nil
+}
+
+// readJpegICCProfile joins the ICC profile from the APP2 segments preceding the image data
+func readJpegICCProfile(reader *bufio.Reader) ([]byte, error) {
+	if _, err := reader.Discard(2); err != nil {
+		return nil, err
+	}
+
+	chunks := make(map[int][]byte)
+	chunkCount := 0
+
+	for {
+		prefix, err := reader.ReadByte()
+		if err != nil {
+			break
+		}
+		if prefix != 0xFF {
+			return nil, errors.New("invalid JPEG marker")
+		}
+
+		marker, err := reader.ReadByte()
+		if err != nil {
+			break
+		}
+
+		// Stop at the start of the image data, as the profile is stored before it
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+		if marker == 0xFF {
+			reader.UnreadByte()
+			continue
+		}
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			continue
+		}
+
+		var length uint16
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil || length < 2 {
+			return nil, errors.New("invalid JPEG segment")
+		}
+
+		segment := make([]byte, length-2)
+		if _, err := io.ReadFull(reader, segment); err != nil {
+			return nil, errors.Wrap(err, "read JPEG segment")
+		}
+
+		if marker == 0xE2 && len(segment) > 14 && bytes.HasPrefix(segment, []byte("ICC_PROFILE\x00")) {
+			chunks[int(segment[12])] = segment[14:]
+			chunkCount = int(segment[13])
+		}
+	}
+
+	if len(chunks) == 0 {
+		return nil, nil
+	}
+
+	profile := make([]byte, 0)
+	for i := 1; i <= chunkCount; i++ {
+		chunk, found := chunks[i]
+		if !found {
+			return nil, errors.Errorf("ICC profile chunk %d of %d missing", i, chunkCount)
+		}
+		profile = append(profile, chunk...)
+	}
+
+	return profile, nil
+}
+
+// readPngICCProfile decompresses the ICC profile from the iCCP chunk preceding the image data
+func readPngICCProfile(reader *bufio.Reader) ([]byte, error) {
+	if _, err := reader.Discard(8); err != nil {
+		return nil, err
+	}
+
+	for {
+		var header struct {
+			Length    uint32
+			ChunkType [4]byte
+		}
+		if err := binary.Read(reader, binary.BigEndian, &header); err != nil {
+			return nil, nil
+		}
+
+		switch string(header.ChunkType[:]) {
+		case "IDAT", "IEND":
+			return nil, nil
+		case "iCCP":
+			chunk := make([]byte, header.Length)
+			if _, err := io.ReadFull(reader, chunk); err != nil {
+				return nil, errors.Wrap(err, "read PNG iCCP chunk")
+			}
+
+			// The chunk holds the profile name, the compression method and the compressed profile
+			nameEnd := bytes.IndexByte(chunk, 0)
+			if nameEnd == -1 || nameEnd+2 > len(chunk) {
+				return nil, errors.New("invalid PNG iCCP chunk")
+			}
+
+			decompressor, err := zlib.NewReader(bytes.NewReader(chunk[nameEnd+2:]))
+			if err != nil {
+				return nil, errors.Wrap(err, "decompress PNG iCCP chunk")
+			}
+			defer decompressor.Close()
+
+			return io.ReadAll(decompressor)
+		}
+
+		// Skip the chunk data and CRC
+		if _, err := reader.Discard(int(header.Length) + 4); err != nil {
+			return nil, nil
+		}
+	}
+}
+
+// readHeifColorProfile reads the colour profile from the `colr` property of a HEIF image,
+// which holds either an ICC profile or the nclx colour primaries and transfer characteristics
+func readHeifColorProfile(file io.ReadSeeker) (*ColorProfile, error) {
+	end, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	colr, err := findHeifBox(file, 0, end, []string{"meta", "iprp", "ipco", "colr"})
+	if err != nil || colr == nil {
+		return nil, err
+	}
+
+	if len(colr) < 4 {
+		return nil, errors.New("invalid HEIF colr box")
+	}
+
+	switch string(colr[0:4]) {
+	case "prof", "rICC":
+		return ParseICCProfile(colr[4:])
+	case "nclx":
+		if len(colr) < 8 {
+			return nil, errors.New("invalid HEIF nclx colr box")
+		}
+		return ColorProfileFromNCLX(binary.BigEndian.Uint16(colr[4:6]), binary.BigEndian.Uint16(colr[6:8]))
+	}
+
+	return nil, nil
+}
+
+// findHeifBox follows the path of box types from the given range of the file, and returns the contents of the last box
+func findHeifBox(file io.ReadSeeker, start int64, end int64, boxPath []string) ([]byte, error) {
+	for offset := start; offset+8 <= end; {
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+
+		var header struct {
+			Size    uint32
+			BoxType [4]byte
+		}
+		if err := binary.Read(file, binary.BigEndian, &header); err != nil {
+			return nil, errors.Wrap(err, "read HEIF box header")
+		}
+
+		headerSize := int64(8)
+		size := int64(header.Size)
+		switch size {
+		case 0:
+			size = end - offset
+		case 1:
+			var largeSize uint64
+			if err := binary.Read(file, binary.BigEndian, &largeSize); err != nil {
+				return nil, errors.Wrap(err, "read HEIF box header")
+			}
+			size = int64(largeSize)
+			headerSize += 8
+		}
+
+		if size < headerSize || offset+size > end {
+			return nil, errors.New("invalid HEIF box size")
+		}
+
+		if string(header.BoxType[:]) == boxPath[0] {
+			contentStart := offset + headerSize
+			// The meta box is a full box, with a version and flags before its children
+			if boxPath[0] == "meta" {
+				contentStart += 4
+			}
+
+			if len(boxPath) > 1 {
+				return findHeifBox(file, contentStart, offset+size, boxPath[1:])
+			}
+
+			if _, err := file.Seek(contentStart, io.SeekStart); err != nil {
+				return nil, err
+			}
+
+			content := make([]byte, offset+size-contentStart)
+			if _, err := io.ReadFull(file, content); err != nil {
+				return nil, errors.Wrap(err, "read HEIF box")
+			}
+
+			return content, nil
+		}
+
+		offset += size
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,111 @@
+package media_utils_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseICCProfile(t *testing.T) {
+	profile, err := media_utils.ParseICCProfile(media_utils.SRGBProfile())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, profile.IsSRGB())
+
+	_, err = media_utils.ParseICCProfile([]byte("not a profile"))
+	assert.Error(t, err)
+}
+
+func TestConvertToSRGB(t *testing.T) {
+	displayP3, err := media_utils.ColorProfileFromNCLX(12, 13)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, displayP3.IsSRGB())
+
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	// Gray is the same in all RGB colour spaces sharing the white point and tone curve
+	img.SetNRGBA(0, 0, color.NRGBA{128, 128, 128, 255})
+	// Pure sRGB red expressed in Display P3
+	img.SetNRGBA(1, 0, color.NRGBA{234, 51, 35, 255})
+
+	converted := media_utils.ConvertToSRGB(img, displayP3)
+
+	gray := converted.NRGBAAt(0, 0)
+	assert.InDelta(t, 128, int(gray.R), 1)
+	assert.InDelta(t, 128, int(gray.G), 1)
+	assert.InDelta(t, 128, int(gray.B), 1)
+
+	red := converted.NRGBAAt(1, 0)
+	assert.InDelta(t, 255, int(red.R), 3)
+	assert.InDelta(t, 0, int(red.G), 3)
+	assert.InDelta(t, 0, int(red.B), 3)
+}
+
+func TestReadColorProfile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	plainJpeg := encodeTestJpeg(t, 8, 8)
+	plainPath := path.Join(tempDir, "plain.jpg")
+	assert.NoError(t, os.WriteFile(plainPath, plainJpeg, 0644))
+
+	profile, err := media_utils.ReadColorProfile(plainPath)
+	assert.NoError(t, err)
+	assert.Nil(t, profile)
+
+	profileJpeg, err := media_utils.EmbedJpegICCProfile(plainJpeg, media_utils.SRGBProfile())
+	if !assert.NoError(t, err) {
+		return
+	}
+	jpegPath := path.Join(tempDir, "profile.jpg")
+	assert.NoError(t, os.WriteFile(jpegPath, profileJpeg, 0644))
+
+	profile, err = media_utils.ReadColorProfile(jpegPath)
+	if assert.NoError(t, err) && assert.NotNil(t, profile) {
+		assert.True(t, profile.IsSRGB())
+	}
+
+	pngPath := path.Join(tempDir, "profile.png")
+	assert.NoError(t, os.WriteFile(pngPath, encodeTestPngWithProfile(t, media_utils.SRGBProfile()), 0644))
+
+	profile, err = media_utils.ReadColorProfile(pngPath)
+	if assert.NoError(t, err) && assert.NotNil(t, profile) {
+		assert.True(t, profile.IsSRGB())
+	}
+}
+
+// encodeTestPngWithProfile encodes a PNG image and inserts an iCCP chunk right after the header chunk
+func encodeTestPngWithProfile(t *testing.T, profile []byte) []byte {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, image.NewGray(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatalf("unable to encode test png: %s", err)
+	}
+
+	var compressed bytes.Buffer
+	writer := zlib.NewWriter(&compressed)
+	writer.Write(profile)
+	writer.Close()
+
+	chunkData := append([]byte("sRGB\x00\x00"), compressed.Bytes()...)
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(chunkData)))
+	chunk = append(chunk, "iCCP"...)
+	chunk = append(chunk, chunkData...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	// The signature is 8 bytes, followed by the 25 bytes of the IHDR chunk
+	data := encoded.Bytes()
+	result := append([]byte{}, data[:33]...)
+	result = append(result, chunk...)
+	return append(result, data[33:]...)
+}
@@ -0,0 +1,70 @@
+package media_utils
+
+import (
+	"image"
+	"math"
+	"os"
+)
+
+// FrameStatistics describes the tonal range of an image, on a scale from 0 to 255
+type FrameStatistics struct {
+	// Brightness is the mean luma of the image
+	Brightness float64
+	// Contrast is the standard deviation of the luma of the image
+	Contrast float64
+}
+
+// IsUsablePoster returns false for frames that are nearly black or without contrast,
+// such as fades, transitions and blurry pans.
+func (stats FrameStatistics) IsUsablePoster() bool {
+	return stats.Brightness >= 20 && stats.Contrast >= 12
+}
+
+// GetFrameStatistics computes the statistics of the image, sampling at most 128 pixels in each direction
+func GetFrameStatistics(img image.Image) FrameStatistics {
+	bounds := img.Bounds()
+
+	stepX := bounds.Dx()/128 + 1
+	stepY := bounds.Dy()/128 + 1
+
+	var sum, sumSquared, count float64
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += stepY {
+		for x := bounds.Min.X; x < bounds.Max.X; x += stepX {
+			r, g, b, _ := img.At(x, y).RGBA()
+			luma := (0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)) / 257
+
+			sum += luma
+			sumSquared += luma * luma
+			count++
+		}
+	}
+
+	if count == 0 {
+		return FrameStatistics{}
+	}
+
+	mean := sum / count
+	variance := math.Max(sumSquared/count-mean*mean, 0)
+
+	return FrameStatistics{
+		Brightness: mean,
+		Contrast:   math.Sqrt(variance),
+	}
+}
+
+// ReadFrameStatistics decodes the image at the given path and computes its statistics
+func ReadFrameStatistics(imagePath string) (*FrameStatistics, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := GetFrameStatistics(img)
+	return &stats, nil
+}
@@ -0,0 +1,38 @@
+package media_utils_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameStatistics(t *testing.T) {
+	black := image.NewGray(image.Rect(0, 0, 64, 64))
+	assert.False(t, media_utils.GetFrameStatistics(black).IsUsablePoster())
+
+	flat := image.NewGray(image.Rect(0, 0, 64, 64))
+	for i := range flat.Pix {
+		flat.Pix[i] = 128
+	}
+	flatStats := media_utils.GetFrameStatistics(flat)
+	assert.InDelta(t, 128, flatStats.Brightness, 0.5)
+	assert.False(t, flatStats.IsUsablePoster())
+
+	checkered := image.NewGray(image.Rect(0, 0, 64, 64))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			if (x/8+y/8)%2 == 0 {
+				checkered.SetGray(x, y, color.Gray{Y: 200})
+			} else {
+				checkered.SetGray(x, y, color.Gray{Y: 40})
+			}
+		}
+	}
+	checkeredStats := media_utils.GetFrameStatistics(checkered)
+	assert.InDelta(t, 120, checkeredStats.Brightness, 0.5)
+	assert.InDelta(t, 80, checkeredStats.Contrast, 0.5)
+	assert.True(t, checkeredStats.IsUsablePoster())
+}
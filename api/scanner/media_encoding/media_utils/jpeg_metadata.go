@@ -0,0 +1,357 @@
+package media_utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// MetadataPolicy decides which metadata of the original photo is kept in an image derived from it
+type MetadataPolicy string
+
+const (
+	// MetadataKeepAll copies all EXIF, XMP and IPTC metadata, including the location
+	MetadataKeepAll MetadataPolicy = "all"
+	// MetadataKeepBasic only keeps the capture date, the camera and the orientation
+	MetadataKeepBasic MetadataPolicy = "basic"
+	// MetadataStrip removes all metadata
+	MetadataStrip MetadataPolicy = "none"
+)
+
+func ParseMetadataPolicy(value string) (MetadataPolicy, error) {
+	switch policy := MetadataPolicy(value); policy {
+	case MetadataKeepAll, MetadataKeepBasic, MetadataStrip:
+		return policy, nil
+	}
+
+	return "", errors.Errorf("invalid metadata policy %q, expected one of: all, basic, none", value)
+}
+
+const (
+	jpegMarkerAPP0  = 0xE0
+	jpegMarkerAPP1  = 0xE1
+	jpegMarkerAPP13 = 0xED
+	jpegMarkerCOM   = 0xFE
+	jpegMarkerSOS   = 0xDA
+)
+
+// JpegSegment is a marker segment from the header of a JPEG file, with the data following the length
+type JpegSegment struct {
+	Marker byte
+	Data   []byte
+}
+
+// IsMetadata returns true for segments holding EXIF, XMP, IPTC or comments
+func (segment JpegSegment) IsMetadata() bool {
+	switch segment.Marker {
+	case jpegMarkerAPP1, jpegMarkerAPP13, jpegMarkerCOM:
+		return true
+	}
+	return false
+}
+
+// IsExif returns true for the APP1 segment holding the EXIF metadata
+func (segment JpegSegment) IsExif() bool {
+	return segment.Marker == jpegMarkerAPP1 && bytes.HasPrefix(segment.Data, []byte("Exif\x00\x00"))
+}
+
+// splitJpegHeader returns the marker segments preceding the image data, and the offset where the image data begins
+func splitJpegHeader(data []byte) ([]JpegSegment, int, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, 0, errors.New("invalid JPEG data")
+	}
+
+	segments := make([]JpegSegment, 0)
+	offset := 2
+
+	for offset+4 <= len(data) {
+		if data[offset] != 0xFF {
+			return nil, 0, errors.Errorf("invalid JPEG marker at offset %d", offset)
+		}
+
+		marker := data[offset+1]
+		if marker == 0xFF {
+			offset++
+			continue
+		}
+
+		if marker == jpegMarkerSOS {
+			return segments, offset, nil
+		}
+
+		length := int(binary.BigEndian.Uint16(data[offset+2 : offset+4]))
+		if length < 2 || offset+2+length > len(data) {
+			return nil, 0, errors.Errorf("invalid JPEG segment length at offset %d", offset)
+		}
+
+		segments = append(segments, JpegSegment{
+			Marker: marker,
+			Data:   data[offset+4 : offset+2+length],
+		})
+
+		offset += 2 + length
+	}
+
+	return nil, 0, errors.New("JPEG image data not found")
+}
+
+// ReadJpegMetadata returns the EXIF, XMP, IPTC and comment segments of the JPEG data
+func ReadJpegMetadata(data []byte) ([]JpegSegment, error) {
+	segments, _, err := splitJpegHeader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]JpegSegment, 0)
+	for _, segment := range segments {
+		if segment.IsMetadata() {
+			metadata = append(metadata, segment)
+		}
+	}
+
+	return metadata, nil
+}
+
+// ReplaceJpegMetadata removes the EXIF, XMP, IPTC and comment segments of the JPEG data, and inserts the given segments instead
+func ReplaceJpegMetadata(data []byte, metadata []JpegSegment) ([]byte, error) {
+	segments, imageStart, err := splitJpegHeader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]byte, 0, len(data))
+	output = append(output, 0xFF, 0xD8)
+
+	writeSegment := func(segment JpegSegment) error {
+		if len(segment.Data)+2 > 0xFFFF {
+			return errors.Errorf("JPEG segment too large (%d bytes)", len(segment.Data))
+		}
+
+		output = append(output, 0xFF, segment.Marker)
+		output = binary.BigEndian.AppendUint16(output, uint16(len(segment.Data)+2))
+		output = append(output, segment.Data...)
+		return nil
+	}
+
+	// The JFIF segment has to come first, directly followed by the EXIF segment
+	inserted := false
+	for _, segment := range segments {
+		if segment.IsMetadata() {
+			continue
+		}
+
+		if !inserted && segment.Marker != jpegMarkerAPP0 {
+			for _, metadataSegment := range metadata {
+				if err := writeSegment(metadataSegment); err != nil {
+					return nil, err
+				}
+			}
+			inserted = true
+		}
+
+		if err := writeSegment(segment); err != nil {
+			return nil, err
+		}
+	}
+
+	return append(output, data[imageStart:]...), nil
+}
+
+// ResetExifOrientation sets the orientation of the EXIF segment to normal, as used for images that are already rotated
+func ResetExifOrientation(segment JpegSegment) (JpegSegment, error) {
+	if !segment.IsExif() {
+		return segment, nil
+	}
+
+	tiff := append([]byte{}, segment.Data[6:]...)
+	if len(tiff) < 8 {
+		return segment, errors.New("invalid EXIF data")
+	}
+
+	var byteOrder binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		byteOrder = binary.LittleEndian
+	case "MM":
+		byteOrder = binary.BigEndian
+	default:
+		return segment, errors.New("invalid EXIF byte order")
+	}
+
+	ifdOffset := int(byteOrder.Uint32(tiff[4:8]))
+	if ifdOffset+2 > len(tiff) {
+		return segment, errors.New("invalid EXIF IFD offset")
+	}
+
+	entryCount := int(byteOrder.Uint16(tiff[ifdOffset:]))
+	for i := 0; i < entryCount; i++ {
+		entry := ifdOffset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return segment, errors.New("invalid EXIF IFD entry")
+		}
+
+		if byteOrder.Uint16(tiff[entry:]) == exifTagOrientation {
+			byteOrder.PutUint16(tiff[entry+8:], 1)
+		}
+	}
+
+	return JpegSegment{
+		Marker: segment.Marker,
+		Data:   append([]byte("Exif\x00\x00"), tiff...),
+	}, nil
+}
+
+// ExifDirectory is one of the image file directories of the EXIF data
+type ExifDirectory int
+
+const (
+	ExifIFD0 ExifDirectory = iota
+	ExifSubIFD
+	ExifGPSIFD
+)
+
+const (
+	exifTagOrientation = 0x0112
+	exifTagSubIFD      = 0x8769
+	exifTagGPSIFD      = 0x8825
+)
+
+const (
+	exifTypeByte      = 1
+	exifTypeASCII     = 2
+	exifTypeShort     = 3
+	exifTypeLong      = 4
+	exifTypeRational  = 5
+	exifTypeUndefined = 7
+)
+
+type exifEntry struct {
+	dataType uint16
+	count    uint32
+	data     []byte
+}
+
+// ExifWriter builds an EXIF segment from individual tags
+type ExifWriter struct {
+	directories [3]map[uint16]exifEntry
+}
+
+func NewExifWriter() *ExifWriter {
+	writer := ExifWriter{}
+	for i := range writer.directories {
+		writer.directories[i] = make(map[uint16]exifEntry)
+	}
+	return &writer
+}
+
+func (writer *ExifWriter) SetASCII(directory ExifDirectory, tag uint16, value string) {
+	data := append([]byte(value), 0)
+	writer.directories[directory][tag] = exifEntry{exifTypeASCII, uint32(len(data)), data}
+}
+
+func (writer *ExifWriter) SetShort(directory ExifDirectory, tag uint16, value uint16) {
+	writer.directories[directory][tag] = exifEntry{exifTypeShort, 1, binary.BigEndian.AppendUint16(nil, value)}
+}
+
+func (writer *ExifWriter) SetBytes(directory ExifDirectory, tag uint16, value []byte) {
+	writer.directories[directory][tag] = exifEntry{exifTypeByte, uint32(len(value)), value}
+}
+
+func (writer *ExifWriter) SetUndefined(directory ExifDirectory, tag uint16, value []byte) {
+	writer.directories[directory][tag] = exifEntry{exifTypeUndefined, uint32(len(value)), value}
+}
+
+// SetRationals sets a tag to a list of unsigned fractions, given as numerator and denominator pairs
+func (writer *ExifWriter) SetRationals(directory ExifDirectory, tag uint16, values ...[2]uint32) {
+	data := make([]byte, 0, len(values)*8)
+	for _, value := range values {
+		data = binary.BigEndian.AppendUint32(data, value[0])
+		data = binary.BigEndian.AppendUint32(data, value[1])
+	}
+	writer.directories[directory][tag] = exifEntry{exifTypeRational, uint32(len(values)), data}
+}
+
+// Segment returns the APP1 segment holding the EXIF data, stored in big endian byte order
+func (writer *ExifWriter) Segment() JpegSegment {
+	directories := writer.directories
+
+	// Placeholders for the pointers to the sub directories, set once their offsets are known
+	if len(directories[ExifSubIFD]) > 0 {
+		directories[ExifIFD0][exifTagSubIFD] = exifEntry{exifTypeLong, 1, make([]byte, 4)}
+	}
+	if len(directories[ExifGPSIFD]) > 0 {
+		directories[ExifIFD0][exifTagGPSIFD] = exifEntry{exifTypeLong, 1, make([]byte, 4)}
+	}
+
+	directorySize := func(entries map[uint16]exifEntry) int {
+		size := 2 + len(entries)*12 + 4
+		for _, entry := range entries {
+			if len(entry.data) > 4 {
+				size += len(entry.data) + len(entry.data)%2
+			}
+		}
+		return size
+	}
+
+	offsets := [3]int{8, 0, 0}
+	offsets[ExifSubIFD] = offsets[ExifIFD0] + directorySize(directories[ExifIFD0])
+	offsets[ExifGPSIFD] = offsets[ExifSubIFD]
+	if len(directories[ExifSubIFD]) > 0 {
+		offsets[ExifGPSIFD] += directorySize(directories[ExifSubIFD])
+	}
+
+	if len(directories[ExifSubIFD]) > 0 {
+		directories[ExifIFD0][exifTagSubIFD] = exifEntry{exifTypeLong, 1, binary.BigEndian.AppendUint32(nil, uint32(offsets[ExifSubIFD]))}
+	}
+	if len(directories[ExifGPSIFD]) > 0 {
+		directories[ExifIFD0][exifTagGPSIFD] = exifEntry{exifTypeLong, 1, binary.BigEndian.AppendUint32(nil, uint32(offsets[ExifGPSIFD]))}
+	}
+
+	tiff := []byte("MM\x00\x2A\x00\x00\x00\x08")
+
+	for directory, entries := range directories {
+		if directory != int(ExifIFD0) && len(entries) == 0 {
+			continue
+		}
+
+		tags := make([]int, 0, len(entries))
+		for tag := range entries {
+			tags = append(tags, int(tag))
+		}
+		sort.Ints(tags)
+
+		dataOffset := offsets[directory] + 2 + len(entries)*12 + 4
+		values := make([]byte, 0)
+
+		tiff = binary.BigEndian.AppendUint16(tiff, uint16(len(entries)))
+		for _, tag := range tags {
+			entry := entries[uint16(tag)]
+			tiff = binary.BigEndian.AppendUint16(tiff, uint16(tag))
+			tiff = binary.BigEndian.AppendUint16(tiff, entry.dataType)
+			tiff = binary.BigEndian.AppendUint32(tiff, entry.count)
+
+			if len(entry.data) <= 4 {
+				value := make([]byte, 4)
+				copy(value, entry.data)
+				tiff = append(tiff, value...)
+			} else {
+				tiff = binary.BigEndian.AppendUint32(tiff, uint32(dataOffset+len(values)))
+				values = append(values, entry.data...)
+				if len(entry.data)%2 == 1 {
+					values = append(values, 0)
+				}
+			}
+		}
+
+		// No next directory
+		tiff = binary.BigEndian.AppendUint32(tiff, 0)
+		tiff = append(tiff, values...)
+	}
+
+	return JpegSegment{
+		Marker: jpegMarkerAPP1,
+		Data:   append([]byte("Exif\x00\x00"), tiff...),
+	}
+}
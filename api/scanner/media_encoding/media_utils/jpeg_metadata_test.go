@@ -0,0 +1,89 @@
+package media_utils_test
+
+import (
+	"bytes"
+	"image/jpeg"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/xor-gate/goexif2/exif"
+)
+
+func TestExifWriter(t *testing.T) {
+	writer := media_utils.NewExifWriter()
+	writer.SetASCII(media_utils.ExifIFD0, 0x010F, "Canon")
+	writer.SetShort(media_utils.ExifIFD0, 0x0112, 6)
+	writer.SetASCII(media_utils.ExifSubIFD, 0x9003, "2021:03:04 05:06:07")
+	writer.SetASCII(media_utils.ExifGPSIFD, 0x0001, "N")
+	writer.SetRationals(media_utils.ExifGPSIFD, 0x0002, [2]uint32{55, 1}, [2]uint32{30, 1}, [2]uint32{0, 1})
+	writer.SetASCII(media_utils.ExifGPSIFD, 0x0003, "E")
+	writer.SetRationals(media_utils.ExifGPSIFD, 0x0004, [2]uint32{12, 1}, [2]uint32{15, 1}, [2]uint32{0, 1})
+
+	segment := writer.Segment()
+	jpegData, err := media_utils.ReplaceJpegMetadata(encodeTestJpeg(t, 8, 8), []media_utils.JpegSegment{segment})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = jpeg.Decode(bytes.NewReader(jpegData))
+	assert.NoError(t, err)
+
+	decoded, err := exif.Decode(bytes.NewReader(jpegData))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	maker, err := decoded.Get(exif.Make)
+	if assert.NoError(t, err) {
+		value, _ := maker.StringVal()
+		assert.Equal(t, "Canon", value)
+	}
+
+	date, err := decoded.Get(exif.DateTimeOriginal)
+	if assert.NoError(t, err) {
+		value, _ := date.StringVal()
+		assert.Equal(t, "2021:03:04 05:06:07", value)
+	}
+
+	latitude, longitude, err := decoded.LatLong()
+	if assert.NoError(t, err) {
+		assert.InDelta(t, 55.5, latitude, 0.0001)
+		assert.InDelta(t, 12.25, longitude, 0.0001)
+	}
+
+	resetSegment, err := media_utils.ResetExifOrientation(segment)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	jpegData, err = media_utils.ReplaceJpegMetadata(jpegData, []media_utils.JpegSegment{resetSegment})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	decoded, err = exif.Decode(bytes.NewReader(jpegData))
+	if assert.NoError(t, err) {
+		orientation, err := decoded.Get(exif.Orientation)
+		if assert.NoError(t, err) {
+			value, _ := orientation.Int(0)
+			assert.Equal(t, 1, value)
+		}
+	}
+
+	strippedData, err := media_utils.ReplaceJpegMetadata(jpegData, nil)
+	if assert.NoError(t, err) {
+		metadata, err := media_utils.ReadJpegMetadata(strippedData)
+		assert.NoError(t, err)
+		assert.Empty(t, metadata)
+	}
+}
+
+func TestParseMetadataPolicy(t *testing.T) {
+	policy, err := media_utils.ParseMetadataPolicy("basic")
+	assert.NoError(t, err)
+	assert.Equal(t, media_utils.MetadataKeepBasic, policy)
+
+	_, err = media_utils.ParseMetadataPolicy("some")
+	assert.Error(t, err)
+}
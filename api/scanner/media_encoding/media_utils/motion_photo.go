@@ -0,0 +1,128 @@
+package media_utils
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// EmbeddedVideo describes the location of a video clip that is appended to the end of a still image,
+// as is done by Google and Samsung motion photos.
+type EmbeddedVideo struct {
+	Offset int64
+	Length int64
+}
+
+var (
+	microVideoOffsetAttr = regexp.MustCompile(`GCamera:MicroVideoOffset\s*=\s*"(\d+)"`)
+	microVideoOffsetElem = regexp.MustCompile(`<GCamera:MicroVideoOffset>(\d+)</GCamera:MicroVideoOffset>`)
+	containerItemElem    = regexp.MustCompile(`<Container:Item\b[^>]*>`)
+	containerItemLength  = regexp.MustCompile(`Item:Length\s*=\s*"(\d+)"`)
+	samsungMotionMarker  = []byte("MotionPhoto_Data")
+)
+
+// motionPhotoHintSize is how much of the beginning of a file is searched for motion photo metadata,
+// before deciding whether the rest of the file has to be read
+const motionPhotoHintSize = 256 * 1024
+
+// FindEmbeddedVideo looks for an MP4 clip embedded in a motion photo.
+// It returns nil if the image does not contain a video.
+func FindEmbeddedVideo(imagePath string) (*EmbeddedVideo, error) {
+	file, err := os.Open(imagePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open motion photo (%s)", imagePath)
+	}
+	defer file.Close()
+
+	head := make([]byte, motionPhotoHintSize)
+	headSize, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, errors.Wrapf(err, "read motion photo (%s)", imagePath)
+	}
+
+	head = head[:headSize]
+	if !bytes.Contains(head, []byte("MicroVideo")) && !bytes.Contains(head, []byte("MotionPhoto")) {
+		return nil, nil
+	}
+
+	rest, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read motion photo (%s)", imagePath)
+	}
+	data := append(head, rest...)
+
+	size := int64(len(data))
+	candidates := make([]int64, 0)
+
+	// Google motion photos (version 1) store the offset from the end of the file in the XMP metadata
+	for _, expr := range []*regexp.Regexp{microVideoOffsetAttr, microVideoOffsetElem} {
+		if match := expr.FindSubmatch(data); match != nil {
+			if offset, err := strconv.ParseInt(string(match[1]), 10, 64); err == nil {
+				candidates = append(candidates, size-offset)
+			}
+		}
+	}
+
+	// Google motion photos (version 2) describe the video as an item of the XMP container directory
+	for _, item := range containerItemElem.FindAll(data, -1) {
+		if !bytes.Contains(item, []byte(`"MotionPhoto"`)) {
+			continue
+		}
+
+		if match := containerItemLength.FindSubmatch(item); match != nil {
+			if length, err := strconv.ParseInt(string(match[1]), 10, 64); err == nil {
+				candidates = append(candidates, size-length)
+			}
+		}
+	}
+
+	// Samsung motion photos put the video right after a marker in the trailer of the file
+	if index := bytes.LastIndex(data, samsungMotionMarker); index != -1 {
+		candidates = append(candidates, int64(index+len(samsungMotionMarker)))
+	}
+
+	for _, offset := range candidates {
+		if isMP4Header(data, offset) {
+			return &EmbeddedVideo{
+				Offset: offset,
+				Length: size - offset,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ExtractEmbeddedVideo copies the embedded video of a motion photo to the output path
+func ExtractEmbeddedVideo(imagePath string, video *EmbeddedVideo, outputPath string) error {
+	input, err := os.Open(imagePath)
+	if err != nil {
+		return errors.Wrapf(err, "open motion photo (%s)", imagePath)
+	}
+	defer input.Close()
+
+	output, err := os.Create(outputPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not create file: %s", outputPath)
+	}
+	defer output.Close()
+
+	if _, err := io.Copy(output, io.NewSectionReader(input, video.Offset, video.Length)); err != nil {
+		return errors.Wrapf(err, "extract embedded video (%s)", imagePath)
+	}
+
+	return nil
+}
+
+// isMP4Header checks that an ISO base media file type box starts at the given offset
+func isMP4Header(data []byte, offset int64) bool {
+	if offset <= 0 || offset+8 > int64(len(data)) {
+		return false
+	}
+
+	return bytes.Equal(data[offset+4:offset+8], []byte("ftyp"))
+}
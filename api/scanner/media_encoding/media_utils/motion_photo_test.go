@@ -0,0 +1,90 @@
+package media_utils_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+var fakeMP4 = []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42isom")
+
+func writeTestFile(t *testing.T, name string, parts ...[]byte) string {
+	filePath := path.Join(t.TempDir(), name)
+
+	content := make([]byte, 0)
+	for _, part := range parts {
+		content = append(content, part...)
+	}
+
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		t.Fatalf("unable to write test file: %s", err)
+	}
+
+	return filePath
+}
+
+func TestFindEmbeddedVideo(t *testing.T) {
+	jpeg := []byte("\xff\xd8 jpeg data \xff\xd9")
+
+	t.Run("Google micro video", func(t *testing.T) {
+		xmp := []byte(fmt.Sprintf(`<rdf:Description GCamera:MicroVideo="1" GCamera:MicroVideoOffset="%d"/>`, len(fakeMP4)))
+		imagePath := writeTestFile(t, "MVIMG_0001.jpg", xmp, jpeg, fakeMP4)
+
+		video, err := media_utils.FindEmbeddedVideo(imagePath)
+		assert.NoError(t, err)
+		if assert.NotNil(t, video) {
+			assert.EqualValues(t, len(xmp)+len(jpeg), video.Offset)
+			assert.EqualValues(t, len(fakeMP4), video.Length)
+		}
+	})
+
+	t.Run("Google motion photo container", func(t *testing.T) {
+		xmp := []byte(fmt.Sprintf(`<Container:Item Item:Mime="video/mp4" Item:Semantic="MotionPhoto" Item:Length="%d"/>`, len(fakeMP4)))
+		imagePath := writeTestFile(t, "PXL_0001.MP.jpg", xmp, jpeg, fakeMP4)
+
+		video, err := media_utils.FindEmbeddedVideo(imagePath)
+		assert.NoError(t, err)
+		if assert.NotNil(t, video) {
+			assert.EqualValues(t, len(xmp)+len(jpeg), video.Offset)
+		}
+	})
+
+	t.Run("Samsung motion photo", func(t *testing.T) {
+		marker := []byte("MotionPhoto_Data")
+		imagePath := writeTestFile(t, "20240101_120000.jpg", jpeg, marker, fakeMP4)
+
+		video, err := media_utils.FindEmbeddedVideo(imagePath)
+		assert.NoError(t, err)
+		if assert.NotNil(t, video) {
+			assert.EqualValues(t, len(jpeg)+len(marker), video.Offset)
+
+			outputPath := path.Join(t.TempDir(), "motion.mp4")
+			assert.NoError(t, media_utils.ExtractEmbeddedVideo(imagePath, video, outputPath))
+
+			extracted, err := os.ReadFile(outputPath)
+			assert.NoError(t, err)
+			assert.Equal(t, fakeMP4, extracted)
+		}
+	})
+
+	t.Run("Regular photo", func(t *testing.T) {
+		imagePath := writeTestFile(t, "photo.jpg", jpeg)
+
+		video, err := media_utils.FindEmbeddedVideo(imagePath)
+		assert.NoError(t, err)
+		assert.Nil(t, video)
+	})
+
+	t.Run("Invalid offset", func(t *testing.T) {
+		xmp := []byte(`GCamera:MicroVideoOffset="3"`)
+		imagePath := writeTestFile(t, "broken.jpg", xmp, jpeg, fakeMP4)
+
+		video, err := media_utils.FindEmbeddedVideo(imagePath)
+		assert.NoError(t, err)
+		assert.Nil(t, video)
+	})
+}
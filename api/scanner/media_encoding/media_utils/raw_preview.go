@@ -0,0 +1,95 @@
+package media_utils
+
+import (
+	"bytes"
+	"image/jpeg"
+)
+
+// FindLargestEmbeddedJpeg searches the data of a RAW file for embedded JPEG previews,
+// and returns the one with the highest resolution, or nil if no decodable preview was found.
+func FindLargestEmbeddedJpeg(data []byte) []byte {
+	var largest []byte
+	largestArea := 0
+
+	soi := []byte{0xFF, 0xD8, 0xFF}
+	for offset := 0; offset < len(data); {
+		index := bytes.Index(data[offset:], soi)
+		if index == -1 {
+			break
+		}
+
+		start := offset + index
+		offset = start + len(soi)
+
+		end := jpegEnd(data, start)
+		if end == -1 {
+			continue
+		}
+
+		// Lossless JPEG, used to store the sensor data itself, is rejected here as it can not be decoded
+		config, err := jpeg.DecodeConfig(bytes.NewReader(data[start:end]))
+		if err != nil {
+			continue
+		}
+
+		if area := config.Width * config.Height; area > largestArea {
+			largest = data[start:end]
+			largestArea = area
+		}
+	}
+
+	return largest
+}
+
+// jpegEnd walks the markers of the JPEG stream starting at the given index,
+// and returns the index right after its end of image marker, or -1 if the stream is invalid
+func jpegEnd(data []byte, start int) int {
+	i := start + 2
+
+	for i+1 < len(data) {
+		if data[i] != 0xFF {
+			return -1
+		}
+
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			// fill byte
+			i++
+			continue
+		case marker == 0xD9:
+			// end of image
+			return i + 2
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			// markers without a payload
+			i += 2
+			continue
+		}
+
+		if i+3 >= len(data) {
+			return -1
+		}
+
+		length := int(data[i+2])<<8 | int(data[i+3])
+		if length < 2 {
+			return -1
+		}
+
+		i += 2 + length
+
+		// start of scan, skip over the entropy coded data to the next marker
+		if marker == 0xDA {
+			for i+1 < len(data) {
+				if data[i] == 0xFF {
+					next := data[i+1]
+					if next != 0x00 && next != 0xFF && !(next >= 0xD0 && next <= 0xD7) {
+						break
+					}
+				}
+				i++
+			}
+		}
+	}
+
+	return -1
+}
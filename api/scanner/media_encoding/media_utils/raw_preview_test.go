@@ -0,0 +1,35 @@
+package media_utils_test
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeTestJpeg(t *testing.T, width int, height int) []byte {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatalf("unable to encode test jpeg: %s", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestFindLargestEmbeddedJpeg(t *testing.T) {
+	thumbnail := encodeTestJpeg(t, 16, 8)
+	preview := encodeTestJpeg(t, 64, 32)
+
+	raw := make([]byte, 0)
+	raw = append(raw, []byte("II*\x00 raw header \xff\xd8\xff broken")...)
+	raw = append(raw, thumbnail...)
+	raw = append(raw, []byte(" sensor data ")...)
+	raw = append(raw, preview...)
+	raw = append(raw, []byte(" trailer")...)
+
+	assert.Equal(t, preview, media_utils.FindLargestEmbeddedJpeg(raw))
+	assert.Nil(t, media_utils.FindLargestEmbeddedJpeg([]byte("no previews here")))
+}
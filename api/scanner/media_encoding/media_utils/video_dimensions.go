@@ -0,0 +1,59 @@
+package media_utils
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/vansante/go-ffprobe.v2"
+)
+
+// GetVideoRotation returns the clockwise rotation in degrees, needed to display the video stream upright.
+// Phones record portrait videos in landscape, and store the rotation in the display matrix or the legacy `rotate` tag.
+func GetVideoRotation(stream *ffprobe.Stream) int {
+	var rotation int
+
+	if displayMatrix, err := stream.SideDataList.GetDisplayMatrix(); err == nil {
+		// The display matrix rotates counterclockwise
+		rotation = -displayMatrix.Rotation
+	} else if rotateTag, err := stream.TagList.GetInt("rotate"); err == nil {
+		rotation = int(rotateTag)
+	}
+
+	return ((rotation % 360) + 360) % 360
+}
+
+// GetVideoDisplayDimensions returns the dimensions of the video stream as it is displayed,
+// correcting for the rotation and for non-square pixels.
+func GetVideoDisplayDimensions(stream *ffprobe.Stream) (width int, height int) {
+	width, height = stream.Width, stream.Height
+
+	if sarWidth, sarHeight, ok := parseRatio(stream.SampleAspectRatio); ok && sarWidth != sarHeight {
+		width = int(math.Round(float64(width) * float64(sarWidth) / float64(sarHeight)))
+	}
+
+	if rotation := GetVideoRotation(stream); rotation == 90 || rotation == 270 {
+		width, height = height, width
+	}
+
+	return width, height
+}
+
+func parseRatio(value string) (int, int, bool) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+
+	numerator, err := strconv.Atoi(parts[0])
+	if err != nil || numerator <= 0 {
+		return 0, 0, false
+	}
+
+	denominator, err := strconv.Atoi(parts[1])
+	if err != nil || denominator <= 0 {
+		return 0, 0, false
+	}
+
+	return numerator, denominator, true
+}
@@ -0,0 +1,39 @@
+package media_utils_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/vansante/go-ffprobe.v2"
+)
+
+func TestGetVideoDisplayDimensions(t *testing.T) {
+	parseStream := func(data string) *ffprobe.Stream {
+		var stream ffprobe.Stream
+		if err := json.Unmarshal([]byte(data), &stream); err != nil {
+			t.Fatalf("unable to parse stream: %s", err)
+		}
+		return &stream
+	}
+
+	landscape := parseStream(`{"width": 1920, "height": 1080}`)
+	assert.Equal(t, 0, media_utils.GetVideoRotation(landscape))
+	width, height := media_utils.GetVideoDisplayDimensions(landscape)
+	assert.Equal(t, []int{1920, 1080}, []int{width, height})
+
+	portrait := parseStream(`{"width": 1920, "height": 1080, "side_data_list": [
+		{"side_data_type": "Display Matrix", "displaymatrix": "", "rotation": -90}
+	]}`)
+	assert.Equal(t, 90, media_utils.GetVideoRotation(portrait))
+	width, height = media_utils.GetVideoDisplayDimensions(portrait)
+	assert.Equal(t, []int{1080, 1920}, []int{width, height})
+
+	legacy := parseStream(`{"width": 1280, "height": 720, "tags": {"rotate": "270"}}`)
+	assert.Equal(t, 270, media_utils.GetVideoRotation(legacy))
+
+	anamorphic := parseStream(`{"width": 720, "height": 576, "sample_aspect_ratio": "16:15"}`)
+	width, height = media_utils.GetVideoDisplayDimensions(anamorphic)
+	assert.Equal(t, []int{768, 576}, []int{width, height})
+}
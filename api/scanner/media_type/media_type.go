@@ -256,7 +256,7 @@ func (imgType *MediaType) IsSupported() bool {
 		return true
 	}
 
-	if executable_worker.DarktableCli.IsInstalled() && imgType.IsRaw() {
+	if executable_worker.HasRawConverter() && imgType.IsRaw() {
 		return true
 	}
 
@@ -0,0 +1,119 @@
+package scanner
+
+import (
+	"sync"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/scanner_tasks"
+	"github.com/photoview/photoview/api/scanner/scanner_utils"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// refreshBatchSize is the number of media loaded at a time when refreshing the metadata of many media
+const refreshBatchSize = 100
+
+// RefreshMetadata reads the metadata of the media again, keeping the dates and locations overridden by users.
+// Media that fail are reported as scanner errors and skipped. It returns the number of media refreshed.
+func RefreshMetadata(db *gorm.DB, media []*models.Media) int {
+	refreshed := 0
+
+	for _, m := range media {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			return scanner_tasks.RefreshMediaMetadata(tx, m)
+		})
+		if err != nil {
+			scanner_utils.ScannerError("refresh metadata of media: %s", err)
+			continue
+		}
+
+		refreshed++
+	}
+
+	return refreshed
+}
+
+// ErrRefreshRunning is returned when the metadata of albums or the library is refreshed while another such refresh is running
+var ErrRefreshRunning = errors.New("the metadata is already being refreshed")
+
+// refreshRunning is held while the metadata of albums or the library is refreshed,
+// such that the same media are not refreshed by two refreshes at once
+var refreshRunning sync.Mutex
+
+// RefreshAlbumMetadata reads the metadata of the media of the album and all albums below it again
+func RefreshAlbumMetadata(db *gorm.DB, albumID int) (int, error) {
+	if !refreshRunning.TryLock() {
+		return 0, ErrRefreshRunning
+	}
+	defer refreshRunning.Unlock()
+
+	return refreshAlbumMetadata(db, albumID)
+}
+
+// RefreshAllMetadata reads the metadata of all media of the library again
+func RefreshAllMetadata(db *gorm.DB) (int, error) {
+	if !refreshRunning.TryLock() {
+		return 0, ErrRefreshRunning
+	}
+	defer refreshRunning.Unlock()
+
+	return refreshMetadataInBatches(db, db)
+}
+
+// RefreshMetadataInBackground refreshes the metadata of the media of the album and the albums below it,
+// or of the whole library if the album is nil, without waiting for the refresh to finish.
+// The done function is called with the result when it has finished, before another refresh can be started.
+func RefreshMetadataInBackground(db *gorm.DB, albumID *int, done func(refreshed int, err error)) error {
+	if !refreshRunning.TryLock() {
+		return ErrRefreshRunning
+	}
+
+	go func() {
+		var refreshed int
+		var err error
+		if albumID != nil {
+			refreshed, err = refreshAlbumMetadata(db, *albumID)
+		} else {
+			refreshed, err = refreshMetadataInBatches(db, db)
+		}
+
+		defer refreshRunning.Unlock()
+		done(refreshed, err)
+	}()
+
+	return nil
+}
+
+func refreshAlbumMetadata(db *gorm.DB, albumID int) (int, error) {
+	albums, err := models.GetChildrenFromAlbums(db, nil, []int{albumID})
+	if err != nil {
+		return 0, errors.Wrap(err, "get sub albums")
+	}
+
+	if len(albums) == 0 {
+		return 0, errors.Errorf("album not found (%d)", albumID)
+	}
+
+	albumIDs := make([]int, len(albums))
+	for i, album := range albums {
+		albumIDs[i] = album.ID
+	}
+
+	return refreshMetadataInBatches(db, db.Where("album_id IN (?)", albumIDs))
+}
+
+// refreshMetadataInBatches refreshes the media found by the query, without loading all of them at once
+func refreshMetadataInBatches(db *gorm.DB, query *gorm.DB) (int, error) {
+	refreshed := 0
+
+	var batch []*models.Media
+	err := query.FindInBatches(&batch, refreshBatchSize, func(_ *gorm.DB, _ int) error {
+		refreshed += RefreshMetadata(db, batch)
+		return nil
+	}).Error
+	if err != nil {
+		return refreshed, errors.Wrap(err, "get media to refresh")
+	}
+
+	return refreshed, nil
+}
@@ -0,0 +1,158 @@
+package scanner_test
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/graphql/models/actions"
+	"github.com/photoview/photoview/api/scanner"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshMetadata(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+	dir := t.TempDir()
+
+	exif.InitializeEXIFParser()
+
+	photoData, err := os.ReadFile("./exif/test_data/bird.jpg")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, os.MkdirAll(path.Join(dir, "birds"), 0755))
+	assert.NoError(t, os.WriteFile(path.Join(dir, "birds", "bird.jpg"), photoData, 0644))
+	assert.NoError(t, os.WriteFile(path.Join(dir, "bird.jpg"), photoData, 0644))
+
+	strippedData, err := os.ReadFile("./exif/test_data/stripped.jpg")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, os.WriteFile(path.Join(dir, "stripped.jpg"), strippedData, 0644))
+
+	fileInfo, err := os.Stat(path.Join(dir, "stripped.jpg"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	fileDate := fileInfo.ModTime()
+
+	rootAlbum := models.Album{
+		Title: "root",
+		Path:  dir,
+	}
+	assert.NoError(t, db.Save(&rootAlbum).Error)
+
+	subAlbum := models.Album{
+		Title:         "birds",
+		Path:          path.Join(dir, "birds"),
+		ParentAlbumID: &rootAlbum.ID,
+	}
+	assert.NoError(t, db.Save(&subAlbum).Error)
+
+	// The media were scanned without reading their metadata, as happens when the parser failed
+	outdatedCamera := "Unknown"
+	rootPhoto := models.Media{
+		Title:   "bird.jpg",
+		Path:    path.Join(dir, "bird.jpg"),
+		AlbumID: rootAlbum.ID,
+		Type:    models.MediaTypePhoto,
+		Exif:    &models.MediaEXIF{Camera: &outdatedCamera},
+	}
+	assert.NoError(t, db.Save(&rootPhoto).Error)
+
+	subPhoto := models.Media{
+		Title:   "bird.jpg",
+		Path:    path.Join(dir, "birds", "bird.jpg"),
+		AlbumID: subAlbum.ID,
+		Type:    models.MediaTypePhoto,
+	}
+	assert.NoError(t, db.Save(&subPhoto).Error)
+
+	// The capture date was removed from the file after it was scanned
+	outdatedDate := time.Date(2012, 5, 6, 10, 0, 0, 0, time.UTC)
+	strippedPhoto := models.Media{
+		Title:    "stripped.jpg",
+		Path:     path.Join(dir, "stripped.jpg"),
+		AlbumID:  rootAlbum.ID,
+		Type:     models.MediaTypePhoto,
+		DateShot: outdatedDate,
+		Exif:     &models.MediaEXIF{DateShot: &outdatedDate, Camera: &outdatedCamera},
+	}
+	assert.NoError(t, db.Save(&strippedPhoto).Error)
+
+	overriddenDate := time.Date(2021, 3, 4, 15, 0, 0, 0, time.UTC)
+	override := models.MediaOverride{
+		MediaID:  subPhoto.ID,
+		DateShot: &overriddenDate,
+	}
+	assert.NoError(t, db.Save(&override).Error)
+
+	password := "1234"
+	user, err := models.RegisterUser(db, "user", &password, false)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Model(&user).Association("Albums").Append(&rootAlbum))
+
+	// The title was edited by the user before the refresh
+	editedTitle := "Bird on a branch"
+	_, err = actions.UpdateMediaMetadata(db, user, rootPhoto.ID, &editedTitle, nil)
+	assert.NoError(t, err)
+
+	loadMedia := func(t *testing.T, mediaID int) *models.Media {
+		var m models.Media
+		assert.NoError(t, db.Preload("Exif").First(&m, mediaID).Error)
+		return &m
+	}
+
+	refreshed, err := scanner.RefreshAlbumMetadata(db, rootAlbum.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, refreshed, "the media of the albums below are refreshed too")
+
+	root := loadMedia(t, rootPhoto.ID)
+	if assert.NotNil(t, root.Exif) {
+		assert.Equal(t, "Canon EOS 600D", *root.Exif.Camera)
+		assert.InDelta(t, 65.0168, *root.Exif.GPSLatitude, 0.001)
+	}
+	assert.Equal(t, 2012, root.DateShot.Year(), "the capture date is read from the metadata")
+	if assert.NotNil(t, root.Exif) && assert.NotNil(t, root.Exif.Title) {
+		assert.Equal(t, editedTitle, *root.Exif.Title, "the edited title is kept")
+	}
+
+	sub := loadMedia(t, subPhoto.ID)
+	if assert.NotNil(t, sub.Exif) {
+		assert.Equal(t, "Canon EOS 600D", *sub.Exif.Camera)
+	}
+	assert.True(t, overriddenDate.Equal(sub.DateShot), "the overridden date is kept")
+
+	stripped := loadMedia(t, strippedPhoto.ID)
+	assert.True(t, fileDate.Equal(stripped.DateShot), "media without a capture date are dated by their file")
+	if assert.NotNil(t, stripped.Exif) {
+		assert.Nil(t, stripped.Exif.DateShot)
+		assert.Nil(t, stripped.Exif.Camera, "metadata no longer in the file is cleared")
+	}
+
+	refreshed, err = scanner.RefreshAllMetadata(db)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, refreshed)
+
+	release := make(chan bool)
+	finished := make(chan int)
+	err = scanner.RefreshMetadataInBackground(db, nil, func(refreshed int, err error) {
+		assert.NoError(t, err)
+		<-release
+		finished <- refreshed
+	})
+	assert.NoError(t, err)
+
+	_, err = scanner.RefreshAlbumMetadata(db, rootAlbum.ID)
+	assert.ErrorIs(t, err, scanner.ErrRefreshRunning, "only one refresh runs at a time")
+
+	close(release)
+	assert.Equal(t, 3, <-finished)
+
+	_, err = scanner.RefreshAlbumMetadata(db, subAlbum.ID+100)
+	assert.Error(t, err)
+}
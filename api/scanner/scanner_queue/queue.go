@@ -21,17 +21,26 @@ import (
 // ScannerJob describes a job on the queue to be run by the scanner over a single album
 type ScannerJob struct {
 	ctx scanner_task.TaskContext
+	// media is set when only this media of the album is processed, instead of the whole album
+	media *models.Media
 	// album *models.Album
 	// cache *scanner_cache.AlbumScannerCache
 }
 
 func NewScannerJob(ctx scanner_task.TaskContext) ScannerJob {
 	return ScannerJob{
-		ctx,
+		ctx: ctx,
 	}
 }
 
 func (job *ScannerJob) Run(db *gorm.DB) {
+	if job.media != nil {
+		if err := scanner.ProcessSingleMedia(db, job.media); err != nil {
+			scanner_utils.ScannerError("Failed to process media: %v", err)
+		}
+		return
+	}
+
 	err := scanner.ScanAlbum(job.ctx)
 	if err != nil {
 		scanner_utils.ScannerError("Failed to scan album: %v", err)
@@ -246,6 +255,23 @@ func AddUserToQueue(user *models.User) error {
 	return nil
 }
 
+// AddMediaToQueue adds a job processing the single media again to the scanner queue,
+// such as when its thumbnail has been removed. Function does not block.
+func AddMediaToQueue(media *models.Media) error {
+	var album models.Album
+	if err := global_scanner_queue.db.Model(media).Association("Album").Find(&album); err != nil {
+		return errors.Wrapf(err, "get album of media (media_id: %d)", media.ID)
+	}
+
+	global_scanner_queue.mutex.Lock()
+	defer global_scanner_queue.mutex.Unlock()
+
+	return global_scanner_queue.addJob(&ScannerJob{
+		ctx:   scanner_task.NewTaskContext(context.Background(), global_scanner_queue.db, &album, scanner_cache.MakeAlbumCache()),
+		media: media,
+	})
+}
+
 // Queue should be locked prior to calling this function
 func (queue *ScannerQueue) addJob(job *ScannerJob) error {
 	if exists, err := queue.jobOnQueue(job); exists || err != nil {
@@ -263,7 +289,15 @@ func (queue *ScannerQueue) jobOnQueue(job *ScannerJob) (bool, error) {
 	scannerJobs := append(queue.in_progress, queue.up_next...)
 
 	for _, scannerJob := range scannerJobs {
-		if scannerJob.ctx.GetAlbum().ID == job.ctx.GetAlbum().ID {
+		if scannerJob.ctx.GetAlbum().ID != job.ctx.GetAlbum().ID {
+			continue
+		}
+
+		// Jobs of single media are only the same as jobs of the same media
+		if scannerJob.media == nil && job.media == nil {
+			return true, nil
+		}
+		if scannerJob.media != nil && job.media != nil && scannerJob.media.ID == job.media.ID {
 			return true, nil
 		}
 	}
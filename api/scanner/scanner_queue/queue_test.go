@@ -24,6 +24,14 @@ func makeScannerJob(albumID int) ScannerJob {
 	return NewScannerJob(scanner_task.NewTaskContext(context.Background(), nil, makeAlbumWithID(albumID), scanner_cache.MakeAlbumCache()))
 }
 
+func makeMediaJob(albumID int, mediaID int) ScannerJob {
+	job := makeScannerJob(albumID)
+	job.media = &models.Media{}
+	job.media.ID = mediaID
+
+	return job
+}
+
 func TestScannerQueue_AddJob(t *testing.T) {
 
 	scannerJobs := []ScannerJob{
@@ -70,6 +78,24 @@ func TestScannerQueue_AddJob(t *testing.T) {
 		}
 
 	})
+
+	t.Run("add media job to scanner queue", func(t *testing.T) {
+		startingJobs := len(mockScannerQueue.up_next)
+
+		job := makeMediaJob(20, 7)
+		if err := mockScannerQueue.addJob(&job); err != nil {
+			t.Errorf(".AddJob() returned an unexpected error: %s", err)
+		}
+
+		sameJob := makeMediaJob(20, 7)
+		if err := mockScannerQueue.addJob(&sameJob); err != nil {
+			t.Errorf(".AddJob() returned an unexpected error: %s", err)
+		}
+
+		if len(mockScannerQueue.up_next) != startingJobs+1 {
+			t.Errorf("Expected scanner queue length to be %d but got %d", startingJobs+1, len(mockScannerQueue.up_next))
+		}
+	})
 }
 
 func TestScannerQueue_JobOnQueue(t *testing.T) {
@@ -93,6 +119,7 @@ func TestScannerQueue_JobOnQueue(t *testing.T) {
 	}{
 		{"album which owner is already on the queue", true, makeScannerJob(100)},
 		{"album that is not on the queue", false, makeScannerJob(321)},
+		{"single media of album already on the queue", false, makeMediaJob(100, 7)},
 	}
 
 	for _, test := range onQueueTests {
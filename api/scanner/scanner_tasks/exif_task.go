@@ -6,6 +6,7 @@ import (
 	"github.com/photoview/photoview/api/graphql/models"
 	"github.com/photoview/photoview/api/scanner/exif"
 	"github.com/photoview/photoview/api/scanner/scanner_task"
+	"gorm.io/gorm"
 )
 
 type ExifTask struct {
@@ -23,5 +24,36 @@ func (t ExifTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models.Me
 		log.Printf("WARN: SaveEXIF for %s failed: %s\n", media.Title, err)
 	}
 
+	saveDocumentMetadata(ctx.GetDB(), media)
+
 	return nil
 }
+
+// saveDocumentMetadata saves the document ids and the panorama metadata of the media,
+// failures are logged as the media can be shown without them
+func saveDocumentMetadata(tx *gorm.DB, media *models.Media) {
+	documentID, derivedFrom, err := exif.ReadDocumentIDs(media.Path)
+	if err == nil && (documentID != nil || derivedFrom != nil) {
+		media.DocumentID = documentID
+		media.DerivedFromDocumentID = derivedFrom
+		err = tx.Model(media).UpdateColumns(map[string]interface{}{
+			"document_id":              documentID,
+			"derived_from_document_id": derivedFrom,
+		}).Error
+	}
+
+	if err != nil {
+		log.Printf("WARN: reading document ids of %s failed: %s\n", media.Title, err)
+	}
+
+	if media.Type == models.MediaTypePhoto {
+		panorama, err := exif.ReadPhotoPanorama(media.Path)
+		if err == nil {
+			err = exif.SavePanorama(tx, media, panorama)
+		}
+
+		if err != nil {
+			log.Printf("WARN: reading panorama metadata of %s failed: %s\n", media.Title, err)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package scanner_tasks
+
+import (
+	"os"
+	"path"
+	"strings"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/gpx"
+	"github.com/photoview/photoview/api/scanner/scanner_task"
+	"github.com/photoview/photoview/api/scanner/scanner_utils"
+	"gorm.io/gorm"
+)
+
+// GPXTask geotags the media of the album without a location, from the GPX tracks found in the album directory
+type GPXTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t GPXTask) AfterScanAlbum(ctx scanner_task.TaskContext, changedMedia []*models.Media, albumMedia []*models.Media) error {
+	album := ctx.GetAlbum()
+
+	entries, err := os.ReadDir(album.Path)
+	if err != nil {
+		scanner_utils.ScannerError("read directory to find GPX tracks (%s): %s", album.Path, err)
+		return nil
+	}
+
+	albumIgnore := getAlbumIgnore(ctx)
+
+	tracks := make([]*gpx.Track, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(path.Ext(entry.Name()), ".gpx") || albumIgnore.MatchesPath(entry.Name()) {
+			continue
+		}
+
+		track, err := gpx.ParseFile(path.Join(album.Path, entry.Name()))
+		if err != nil {
+			scanner_utils.ScannerError("read GPX track: %s", err)
+			continue
+		}
+
+		tracks = append(tracks, track)
+	}
+
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	err = ctx.GetDB().Transaction(func(tx *gorm.DB) error {
+		var media []*models.Media
+		if err := tx.Preload("Exif").Where("album_id = ?", album.ID).Find(&media).Error; err != nil {
+			return err
+		}
+
+		_, err := gpx.GeotagMedia(tx, media, gpx.MergeTracks(tracks...), gpx.DefaultOptions())
+		return err
+	})
+	if err != nil {
+		scanner_utils.ScannerError("geotag media from GPX tracks (%s): %s", album.Path, err)
+	}
+
+	return nil
+}
@@ -2,14 +2,18 @@ package processing_tasks
 
 import (
 	"io/fs"
+	"log"
 	"path"
 	"path/filepath"
 	"strings"
 
+	"github.com/photoview/photoview/api/graphql/models"
 	"github.com/photoview/photoview/api/scanner/media_encoding"
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
 	"github.com/photoview/photoview/api/scanner/media_type"
 	"github.com/photoview/photoview/api/scanner/scanner_task"
 	"github.com/photoview/photoview/api/scanner/scanner_utils"
+	"github.com/photoview/photoview/api/utils"
 	"github.com/pkg/errors"
 )
 
@@ -28,6 +32,44 @@ func (t CounterpartFilesTask) MediaFound(ctx scanner_task.TaskContext, fileInfo
 	return false, nil
 }
 
+// AfterMediaFound records the JPEG file next to a RAW photo as another version of it,
+// and forgets the previous version when the JPEG file has been removed or replaced
+func (t CounterpartFilesTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models.Media, newMedia bool) error {
+	if media.Type != models.MediaTypePhoto {
+		return nil
+	}
+
+	mediaType, err := ctx.GetCache().GetMediaType(media.Path)
+	if err != nil {
+		return errors.Wrap(err, "scan for counterpart file")
+	}
+
+	var counterpartFile *string
+	if mediaType.IsRaw() {
+		counterpartFile = scanForCompressedCounterpartFile(media.Path)
+	}
+
+	if counterpartFile == nil && media.CounterpartPath == nil {
+		return nil
+	}
+
+	if counterpartFile != nil && media.CounterpartPath != nil && *counterpartFile == *media.CounterpartPath {
+		return nil
+	}
+
+	media.CounterpartPath = counterpartFile
+	if err := ctx.GetDB().Model(media).UpdateColumn("counterpart_path", counterpartFile).Error; err != nil {
+		return errors.Wrap(err, "save counterpart path of media")
+	}
+
+	// The url of the new counterpart is created when the photo is processed
+	if err := ctx.GetDB().Where("media_id = ? AND purpose = ?", media.ID, models.MediaCounterpart).Delete(&models.MediaURL{}).Error; err != nil {
+		return errors.Wrap(err, "delete old counterpart url of media")
+	}
+
+	return nil
+}
+
 func (t CounterpartFilesTask) BeforeProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData) (scanner_task.TaskContext, error) {
 
 	mediaType, err := ctx.GetCache().GetMediaType(mediaData.Media.Path)
@@ -40,10 +82,21 @@ func (t CounterpartFilesTask) BeforeProcessMedia(ctx scanner_task.TaskContext, m
 	}
 
 	counterpartFile := scanForCompressedCounterpartFile(mediaData.Media.Path)
-	if counterpartFile != nil {
-		mediaData.CounterpartPath = counterpartFile
+	if counterpartFile == nil {
+		return ctx, nil
 	}
 
+	// Generate the previews from the RAW file itself if configured so, and it can be converted
+	if executable_worker.RawVersionIsPrimary() {
+		return ctx, nil
+	}
+
+	if utils.RawIsPrimaryVersion() {
+		log.Printf("WARN: %s is set to raw, but no RAW converter was found. Using the JPEG version of %s instead\n", utils.EnvRawPrimaryVersion.GetName(), mediaData.Media.Path)
+	}
+
+	mediaData.CounterpartPath = counterpartFile
+
 	return ctx, nil
 }
 
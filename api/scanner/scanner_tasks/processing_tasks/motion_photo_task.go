@@ -0,0 +1,245 @@
+package processing_tasks
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/media_encoding"
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/photoview/photoview/api/scanner/media_type"
+	"github.com/photoview/photoview/api/scanner/scanner_task"
+	"github.com/photoview/photoview/api/scanner/scanner_utils"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Live photos consist of a still image and a video with the same base name
+var livePhotoStillTypes = []media_type.MediaType{media_type.TypeHeic, media_type.TypeJpeg}
+var livePhotoVideoTypes = []media_type.MediaType{media_type.TypeMOV}
+
+// MotionPhotoTask pairs live photos and motion photos with their video clip,
+// and attaches the clip to the still image as a `MotionVideo` media url.
+type MotionPhotoTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t MotionPhotoTask) MediaFound(ctx scanner_task.TaskContext, fileInfo fs.FileInfo, mediaPath string) (skip bool, err error) {
+
+	// Skip the videos that belong to a live photo, they will be attached to the still image instead
+	if scanForLivePhotoStill(mediaPath) != nil {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (t MotionPhotoTask) BeforeProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData) (scanner_task.TaskContext, error) {
+	if mediaData.Media.Type != models.MediaTypePhoto {
+		return ctx, nil
+	}
+
+	if videoPath := scanForLivePhotoVideo(mediaData.Media.Path); videoPath != nil {
+		mediaData.MotionVideoPath = videoPath
+	}
+
+	return ctx, nil
+}
+
+func (t MotionPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
+	if mediaData.Media.Type != models.MediaTypePhoto {
+		return []*models.MediaURL{}, nil
+	}
+
+	photo := mediaData.Media
+
+	motionURL, err := makePhotoURLChecker(ctx.GetDB(), photo.ID)(models.MotionVideo)
+	if err != nil {
+		return []*models.MediaURL{}, errors.Wrap(err, "error processing motion video")
+	}
+
+	if motionURL != nil {
+		hasVideo, err := hasMotionVideo(mediaData)
+		if err != nil {
+			return []*models.MediaURL{}, errors.Wrapf(err, "could not check motion video (%s)", photo.Path)
+		}
+
+		if !hasVideo {
+			// The video clip was removed from the photo, or the video of the live photo was deleted
+			if err := os.Remove(path.Join(mediaCachePath, motionURL.MediaName)); err != nil && !os.IsNotExist(err) {
+				return []*models.MediaURL{}, errors.Wrap(err, "remove motion video no longer found")
+			}
+
+			if err := ctx.GetDB().Delete(motionURL).Error; err != nil {
+				return []*models.MediaURL{}, errors.Wrap(err, "delete motion video no longer found from database")
+			}
+
+			return []*models.MediaURL{}, nil
+		}
+
+		// Verify that motion video still exists in cache
+		motionPath := path.Join(mediaCachePath, motionURL.MediaName)
+		if _, err := os.Stat(motionPath); !os.IsNotExist(err) {
+			return []*models.MediaURL{}, nil
+		}
+
+		fmt.Printf("Motion video found in database but not in cache, re-encoding video to cache: %s\n", motionURL.MediaName)
+	}
+
+	var motionName string
+	if motionURL != nil {
+		motionName = motionURL.MediaName
+	} else {
+		motionName = generateUniqueMediaNamePrefixed("motion", photo.Path, ".mp4")
+	}
+
+	motionPath := path.Join(mediaCachePath, motionName)
+
+	found, err := encodeMotionVideo(mediaData, motionPath)
+	if err != nil {
+		return []*models.MediaURL{}, errors.Wrapf(err, "could not encode motion video (%s)", photo.Path)
+	}
+
+	if !found {
+		return []*models.MediaURL{}, nil
+	}
+
+	updatedURL, err := saveMotionVideoToDB(ctx.GetDB(), photo, motionName, motionPath, motionURL)
+	if err != nil {
+		return []*models.MediaURL{}, err
+	}
+
+	return []*models.MediaURL{updatedURL}, nil
+}
+
+// encodeMotionVideo writes the video clip belonging to the photo to the output path,
+// it returns false if the photo has no video clip.
+func encodeMotionVideo(mediaData *media_encoding.EncodeMediaData, outputPath string) (bool, error) {
+	if mediaData.MotionVideoPath != nil {
+		if !executable_worker.FfmpegCli.IsInstalled() {
+			log.Printf("Skipping video of live photo, as ffmpeg is not installed: %s\n", *mediaData.MotionVideoPath)
+			return false, nil
+		}
+
+		if err := executable_worker.FfmpegCli.EncodeMp4(*mediaData.MotionVideoPath, outputPath); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	embeddedVideo, err := media_utils.FindEmbeddedVideo(mediaData.Media.Path)
+	if err != nil {
+		return false, err
+	}
+
+	if embeddedVideo == nil {
+		return false, nil
+	}
+
+	if err := media_utils.ExtractEmbeddedVideo(mediaData.Media.Path, embeddedVideo, outputPath); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// hasMotionVideo returns whether the photo still has a video clip, either as the video of a live photo or embedded in the photo
+func hasMotionVideo(mediaData *media_encoding.EncodeMediaData) (bool, error) {
+	if mediaData.MotionVideoPath != nil {
+		return true, nil
+	}
+
+	embeddedVideo, err := media_utils.FindEmbeddedVideo(mediaData.Media.Path)
+	if err != nil {
+		return false, err
+	}
+
+	return embeddedVideo != nil, nil
+}
+
+func saveMotionVideoToDB(tx *gorm.DB, photo *models.Media, motionName string, motionPath string, mediaURL *models.MediaURL) (*models.MediaURL, error) {
+	fileStats, err := os.Stat(motionPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of motion video")
+	}
+
+	// The dimensions are informational only, so a missing ffprobe should not fail the photo
+	var width, height int
+	if videoStream, err := ReadVideoStreamMetadata(motionPath); err != nil {
+		log.Printf("WARN: could not read dimensions of motion video (%s): %s\n", photo.Path, err)
+	} else {
+		width, height = media_utils.GetVideoDisplayDimensions(videoStream)
+	}
+
+	if mediaURL == nil {
+		mediaURL = &models.MediaURL{
+			MediaID:     photo.ID,
+			MediaName:   motionName,
+			Width:       width,
+			Height:      height,
+			Purpose:     models.MotionVideo,
+			ContentType: "video/mp4",
+			FileSize:    fileStats.Size(),
+		}
+
+		if err := tx.Create(&mediaURL).Error; err != nil {
+			return nil, errors.Wrapf(err, "could not insert motion video media url (%d, %s)", photo.ID, motionName)
+		}
+	} else {
+		mediaURL.Width = width
+		mediaURL.Height = height
+		mediaURL.FileSize = fileStats.Size()
+
+		if err := tx.Save(&mediaURL).Error; err != nil {
+			return nil, errors.Wrapf(err, "could not update motion video media url (%d, %s)", photo.ID, motionName)
+		}
+	}
+
+	return mediaURL, nil
+}
+
+// scanForLivePhotoVideo returns the video of a live photo, given the path of the still image
+func scanForLivePhotoVideo(imagePath string) *string {
+	return scanForLivePhotoCounterpart(imagePath, livePhotoStillTypes, livePhotoVideoTypes)
+}
+
+// scanForLivePhotoStill returns the still image of a live photo, given the path of the video
+func scanForLivePhotoStill(videoPath string) *string {
+	return scanForLivePhotoCounterpart(videoPath, livePhotoVideoTypes, livePhotoStillTypes)
+}
+
+func scanForLivePhotoCounterpart(mediaPath string, fromTypes []media_type.MediaType, toTypes []media_type.MediaType) *string {
+	fileExtType, found := media_type.GetExtensionMediaType(filepath.Ext(mediaPath))
+	if !found || !containsMediaType(fromTypes, fileExtType) {
+		return nil
+	}
+
+	pathWithoutExt := strings.TrimSuffix(mediaPath, path.Ext(mediaPath))
+	for _, counterpartType := range toTypes {
+		for _, ext := range counterpartType.FileExtensions() {
+			testPath := pathWithoutExt + ext
+			if scanner_utils.FileExists(testPath) {
+				return &testPath
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsMediaType(types []media_type.MediaType, mediaType media_type.MediaType) bool {
+	for _, t := range types {
+		if t == mediaType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -111,6 +111,23 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		updatedURLs = append(updatedURLs, original)
 	}
 
+	// Save the JPEG version of a RAW photo to database
+	if photo.CounterpartPath != nil {
+		counterpartURL, err := photoURLFromDB(models.MediaCounterpart)
+		if err != nil {
+			return []*models.MediaURL{}, err
+		}
+
+		if counterpartURL == nil {
+			counterpart, err := saveCounterpartPhotoToDB(ctx.GetDB(), photo)
+			if err != nil {
+				return []*models.MediaURL{}, errors.Wrap(err, "saving counterpart photo to database")
+			}
+
+			updatedURLs = append(updatedURLs, counterpart)
+		}
+	}
+
 	// Save thumbnail to cache
 	if thumbURL == nil {
 		thumbnailName := generateUniqueMediaNamePrefixed("thumbnail", photo.Path, ".jpg")
@@ -128,7 +145,7 @@ func (t ProcessPhotoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 			updatedURLs = append(updatedURLs, thumbURL)
 			fmt.Printf("Thumbnail photo found in database but not in cache, re-encoding photo to cache: %s\n", thumbURL.MediaName)
 
-			_, err := media_encoding.EncodeThumbnail(ctx.GetDB(), baseImagePath, thumbPath)
+			_, err := media_encoding.EncodeThumbnail(ctx.GetDB(), baseImagePath, thumbPath, photo.Path)
 			if err != nil {
 				return []*models.MediaURL{}, errors.Wrap(err, "could not create thumbnail cached image")
 			}
@@ -2,9 +2,11 @@ package processing_tasks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"os/exec"
 	"path"
 	"strings"
 	"time"
@@ -17,6 +19,7 @@ import (
 	"github.com/photoview/photoview/api/utils"
 	"github.com/pkg/errors"
 	"gopkg.in/vansante/go-ffprobe.v2"
+	"gorm.io/gorm"
 )
 
 type ProcessVideoTask struct {
@@ -69,11 +72,14 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 			return []*models.MediaURL{}, errors.Wrap(err, "reading file stats of original video")
 		}
 
+		// Portrait videos from phones are stored in landscape and rotated on playback
+		width, height := media_utils.GetVideoDisplayDimensions(webMetadata)
+
 		mediaURL := models.MediaURL{
 			MediaID:     video.ID,
 			MediaName:   videoMediaName,
-			Width:       webMetadata.Width,
-			Height:      webMetadata.Height,
+			Width:       width,
+			Height:      height,
 			Purpose:     models.MediaOriginal,
 			ContentType: string(*videoType),
 			FileSize:    fileStats.Size(),
@@ -86,49 +92,30 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 		updatedURLs = append(updatedURLs, &mediaURL)
 	}
 
-	if videoWebURL == nil && !videoType.IsWebCompatible() {
-		web_video_name := fmt.Sprintf("web_video_%s_%s", path.Base(video.Path), utils.GenerateToken())
-		web_video_name = strings.ReplaceAll(web_video_name, ".", "_")
-		web_video_name = strings.ReplaceAll(web_video_name, " ", "_")
-		web_video_name = web_video_name + ".mp4"
-
-		webVideoPath := path.Join(mediaCachePath, web_video_name)
-
-		err = executable_worker.FfmpegCli.EncodeMp4(video.Path, webVideoPath)
-		if err != nil {
-			return []*models.MediaURL{}, errors.Wrapf(err, "could not encode mp4 video (%s)", video.Path)
-		}
+	probeData, err := mediaData.VideoMetadata()
+	if err != nil {
+		return []*models.MediaURL{}, err
+	}
 
-		webMetadata, err := ReadVideoStreamMetadata(webVideoPath)
+	profile := executable_worker.ActiveVideoProfile
+	if profile.NeedsTranscoding(videoType.IsWebCompatible(), probeData) {
+		webURL, err := encodeWebVideo(ctx.GetDB(), video, profile, mediaCachePath, videoWebURL)
 		if err != nil {
-			return []*models.MediaURL{}, errors.Wrapf(err, "failed to read metadata for encoded web-video (%s)", video.Title)
+			return []*models.MediaURL{}, err
 		}
 
-		fileStats, err := os.Stat(webVideoPath)
-		if err != nil {
-			return []*models.MediaURL{}, errors.Wrap(err, "reading file stats of web-optimized video")
+		if webURL != nil {
+			updatedURLs = append(updatedURLs, webURL)
 		}
-
-		mediaURL := models.MediaURL{
-			MediaID:     video.ID,
-			MediaName:   web_video_name,
-			Width:       webMetadata.Width,
-			Height:      webMetadata.Height,
-			Purpose:     models.VideoWeb,
-			ContentType: "video/mp4",
-			FileSize:    fileStats.Size(),
+	} else if videoWebURL != nil {
+		// The original is played directly under the current profile, so the transcoded video is no longer needed
+		if err := os.Remove(path.Join(mediaCachePath, videoWebURL.MediaName)); err != nil && !os.IsNotExist(err) {
+			return []*models.MediaURL{}, errors.Wrap(err, "remove web-video no longer needed")
 		}
 
-		if err := ctx.GetDB().Create(&mediaURL).Error; err != nil {
-			return []*models.MediaURL{}, errors.Wrapf(err, "failed to insert encoded web-video into database (%s)", video.Title)
+		if err := ctx.GetDB().Delete(videoWebURL).Error; err != nil {
+			return []*models.MediaURL{}, errors.Wrap(err, "delete web-video no longer needed from database")
 		}
-
-		updatedURLs = append(updatedURLs, &mediaURL)
-	}
-
-	probeData, err := mediaData.VideoMetadata()
-	if err != nil {
-		return []*models.MediaURL{}, err
 	}
 
 	if videoThumbnailURL == nil {
@@ -139,7 +126,7 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 
 		thumbImagePath := path.Join(mediaCachePath, video_thumb_name)
 
-		err = executable_worker.FfmpegCli.EncodeVideoThumbnail(video.Path, thumbImagePath, probeData)
+		err = executable_worker.FfmpegCli.EncodeVideoThumbnail(video.Path, thumbImagePath, probeData, video.PosterTimestamp)
 		if err != nil {
 			return []*models.MediaURL{}, errors.Wrapf(err, "failed to generate thumbnail for video (%s)", video.Title)
 		}
@@ -177,7 +164,7 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 			fmt.Printf("Video thumbnail found in database but not in cache, re-encoding photo to cache: %s\n", videoThumbnailURL.MediaName)
 			updatedURLs = append(updatedURLs, videoThumbnailURL)
 
-			err = executable_worker.FfmpegCli.EncodeVideoThumbnail(video.Path, thumbImagePath, probeData)
+			err = executable_worker.FfmpegCli.EncodeVideoThumbnail(video.Path, thumbImagePath, probeData, video.PosterTimestamp)
 			if err != nil {
 				return []*models.MediaURL{}, errors.Wrapf(err, "failed to generate thumbnail for video (%s)", video.Title)
 			}
@@ -205,6 +192,80 @@ func (t ProcessVideoTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *
 	return updatedURLs, nil
 }
 
+// encodeWebVideo transcodes the video using the given profile,
+// unless it has already been transcoded with the same parameters and is still in the cache.
+func encodeWebVideo(tx *gorm.DB, video *models.Media, profile *executable_worker.VideoProfile, mediaCachePath string, videoWebURL *models.MediaURL) (*models.MediaURL, error) {
+	parameters := profile.EncodingParameters()
+
+	var webVideoName string
+	if videoWebURL != nil {
+		webVideoName = videoWebURL.MediaName
+
+		// Videos transcoded before profiles were introduced used the default profile
+		encodedWith := executable_worker.DefaultVideoProfile.EncodingParameters()
+		if videoWebURL.EncodingParameters != nil {
+			encodedWith = *videoWebURL.EncodingParameters
+		}
+
+		_, statErr := os.Stat(path.Join(mediaCachePath, webVideoName))
+		if encodedWith == parameters && !os.IsNotExist(statErr) {
+			return nil, nil
+		}
+
+		if encodedWith != parameters {
+			fmt.Printf("Video profile has changed, re-encoding video: %s\n", webVideoName)
+		} else {
+			fmt.Printf("Web-video found in database but not in cache, re-encoding video to cache: %s\n", webVideoName)
+		}
+	} else {
+		webVideoName = fmt.Sprintf("web_video_%s_%s", path.Base(video.Path), utils.GenerateToken())
+		webVideoName = strings.ReplaceAll(webVideoName, ".", "_")
+		webVideoName = strings.ReplaceAll(webVideoName, " ", "_")
+		webVideoName = webVideoName + ".mp4"
+	}
+
+	webVideoPath := path.Join(mediaCachePath, webVideoName)
+
+	// ffmpeg refuses to overwrite the previous encoding
+	if err := os.Remove(webVideoPath); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "remove previous web-video")
+	}
+
+	if err := executable_worker.FfmpegCli.EncodeVideo(video.Path, webVideoPath, profile); err != nil {
+		return nil, errors.Wrapf(err, "could not encode web-video (%s)", video.Path)
+	}
+
+	webMetadata, err := ReadVideoStreamMetadata(webVideoPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read metadata for encoded web-video (%s)", video.Title)
+	}
+
+	fileStats, err := os.Stat(webVideoPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of web-optimized video")
+	}
+
+	if videoWebURL == nil {
+		videoWebURL = &models.MediaURL{
+			MediaID:     video.ID,
+			MediaName:   webVideoName,
+			Purpose:     models.VideoWeb,
+			ContentType: "video/mp4",
+		}
+	}
+
+	videoWebURL.Width, videoWebURL.Height = media_utils.GetVideoDisplayDimensions(webMetadata)
+	videoWebURL.FileSize = fileStats.Size()
+	videoWebURL.EncodingProfile = &profile.Name
+	videoWebURL.EncodingParameters = &parameters
+
+	if err := tx.Save(videoWebURL).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to save encoded web-video to database (%s)", video.Title)
+	}
+
+	return videoWebURL, nil
+}
+
 func ReadVideoMetadata(videoPath string) (*ffprobe.ProbeData, error) {
 	ctx, cancelFn := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancelFn()
@@ -230,3 +291,39 @@ func ReadVideoStreamMetadata(videoPath string) (*ffprobe.Stream, error) {
 
 	return stream, nil
 }
+
+// VideoColorInfo holds the colour properties of a video stream, that are not exposed by the ffprobe package
+type VideoColorInfo struct {
+	ColorTransfer  string `json:"color_transfer"`
+	ColorPrimaries string `json:"color_primaries"`
+}
+
+// ReadVideoColorInfo reads the transfer characteristics and colour primaries of the first video stream
+func ReadVideoColorInfo(videoPath string) (*VideoColorInfo, error) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFn()
+
+	output, err := exec.CommandContext(ctx, "ffprobe",
+		"-loglevel", "fatal",
+		"-print_format", "json",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=color_transfer,color_primaries",
+		videoPath,
+	).Output()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read video color info (%s)", path.Base(videoPath))
+	}
+
+	var data struct {
+		Streams []VideoColorInfo `json:"streams"`
+	}
+	if err := json.Unmarshal(output, &data); err != nil {
+		return nil, errors.Wrapf(err, "parse video color info (%s)", path.Base(videoPath))
+	}
+
+	if len(data.Streams) == 0 {
+		return nil, errors.Errorf("no video stream found (%s)", path.Base(videoPath))
+	}
+
+	return &data.Streams[0], nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/photoview/photoview/api/graphql/models"
 	"github.com/photoview/photoview/api/scanner/media_encoding"
 	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/photoview/photoview/api/scanner/media_type"
 	"github.com/photoview/photoview/api/utils"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -80,3 +81,38 @@ func saveOriginalPhotoToDB(tx *gorm.DB, photo *models.Media, imageData *media_en
 
 	return &mediaURL, nil
 }
+
+func saveCounterpartPhotoToDB(tx *gorm.DB, photo *models.Media) (*models.MediaURL, error) {
+	counterpartPath := *photo.CounterpartPath
+
+	contentType, err := media_type.GetMediaType(counterpartPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading content type of counterpart photo")
+	}
+
+	fileStats, err := os.Stat(counterpartPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of counterpart photo")
+	}
+
+	photoDimensions, err := media_utils.GetPhotoDimensions(counterpartPath)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaURL := models.MediaURL{
+		Media:       photo,
+		MediaName:   generateUniqueMediaName(counterpartPath),
+		Width:       photoDimensions.Width,
+		Height:      photoDimensions.Height,
+		Purpose:     models.MediaCounterpart,
+		ContentType: string(*contentType),
+		FileSize:    fileStats.Size(),
+	}
+
+	if err := tx.Create(&mediaURL).Error; err != nil {
+		return nil, errors.Wrapf(err, "inserting counterpart photo url: %d, %s", photo.ID, photo.Title)
+	}
+
+	return &mediaURL, nil
+}
@@ -1,18 +1,15 @@
 package processing_tasks
 
 import (
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"path"
 
 	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
 	"github.com/photoview/photoview/api/scanner/media_encoding"
 	"github.com/photoview/photoview/api/scanner/scanner_task"
-	"github.com/photoview/photoview/api/scanner/scanner_utils"
 	"github.com/pkg/errors"
 )
 
@@ -21,28 +18,21 @@ type SidecarTask struct {
 }
 
 func (t SidecarTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models.Media, newMedia bool) error {
-	if media.Type != models.MediaTypePhoto || !newMedia {
-		return nil
-	}
-
-	mediaType, err := ctx.GetCache().GetMediaType(media.Path)
-	if err != nil {
-		return errors.Wrap(err, "scan for sidecar file")
-	}
-
-	if !mediaType.IsRaw() {
+	if !newMedia {
 		return nil
 	}
 
 	var sideCarPath *string = nil
 	var sideCarHash *string = nil
 
-	sideCarPath = scanForSideCarFile(media.Path)
-	if sideCarPath != nil {
-		sideCarHash = hashSideCarFile(sideCarPath)
+	sideCarPath = exif.FindXMPSidecar(media.Path)
+	if sideCarPath == nil {
+		return nil
 	}
 
-	// Add sidecar data to media
+	sideCarHash = hashSideCarFile(sideCarPath)
+
+	// Add sidecar data to media, its metadata is imported by the EXIF task
 	media.SideCarPath = sideCarPath
 	media.SideCarHash = sideCarHash
 	if err := ctx.GetDB().Save(media).Error; err != nil {
@@ -53,20 +43,11 @@ func (t SidecarTask) AfterMediaFound(ctx scanner_task.TaskContext, media *models
 }
 
 func (t SidecarTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) (updatedURLs []*models.MediaURL, err error) {
-	mediaType, err := mediaData.ContentType()
-	if err != nil {
-		return []*models.MediaURL{}, errors.Wrap(err, "sidecar task, process media")
-	}
-
-	if !mediaType.IsRaw() {
-		return []*models.MediaURL{}, nil
-	}
-
 	photo := mediaData.Media
 
 	sideCarFileHasChanged := false
 	var currentFileHash *string
-	currentSideCarPath := scanForSideCarFile(photo.Path)
+	currentSideCarPath := exif.FindXMPSidecar(photo.Path)
 
 	if currentSideCarPath != nil {
 		currentFileHash = hashSideCarFile(currentSideCarPath)
@@ -81,7 +62,43 @@ func (t SidecarTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media
 		return []*models.MediaURL{}, nil
 	}
 
-	fmt.Printf("Detected changed sidecar file for %s recreating JPG's to reflect changes\n", photo.Path)
+	fmt.Printf("Detected changed sidecar file for %s importing its metadata\n", photo.Path)
+
+	photo.SideCarPath = currentSideCarPath
+	if _, err := exif.ReimportEXIF(ctx.GetDB(), photo); err != nil {
+		log.Printf("WARN: importing sidecar metadata of %s failed: %s\n", photo.Path, err)
+	}
+
+	updatedURLs = []*models.MediaURL{}
+
+	mediaType, err := mediaData.ContentType()
+	if err != nil {
+		return []*models.MediaURL{}, errors.Wrap(err, "sidecar task, process media")
+	}
+
+	// The sidecar of a RAW file holds the edits applied by the RAW converters
+	if mediaType.IsRaw() {
+		updatedURLs, err = rerenderRawPhoto(ctx, mediaData, mediaCachePath)
+		if err != nil {
+			return []*models.MediaURL{}, err
+		}
+	}
+
+	photo.SideCarHash = currentFileHash
+
+	// save new side car hash
+	if err := ctx.GetDB().Save(&photo).Error; err != nil {
+		return []*models.MediaURL{}, errors.Wrapf(err, "could not update side car hash for media: %s", photo.Path)
+	}
+
+	return updatedURLs, nil
+}
+
+// rerenderRawPhoto recreates the high-res image and the thumbnail of a RAW photo, to reflect the edits of its sidecar
+func rerenderRawPhoto(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
+	photo := mediaData.Media
+
+	fmt.Printf("Recreating JPG's of %s to reflect the changed sidecar\n", photo.Path)
 
 	highResURL, err := photo.GetHighRes()
 	if err != nil {
@@ -115,45 +132,22 @@ func (t SidecarTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media
 	}
 	os.Remove(tempThumbPath)
 
-	photo.SideCarHash = currentFileHash
-	photo.SideCarPath = currentSideCarPath
-
-	// save new side car hash
-	if err := ctx.GetDB().Save(&photo).Error; err != nil {
-		return []*models.MediaURL{}, errors.Wrapf(err, "could not update side car hash for media: %s", photo.Path)
-	}
-
 	return []*models.MediaURL{
 		updatedThumbnail,
 		updatedHighRes,
 	}, nil
 }
 
-func scanForSideCarFile(path string) *string {
-	testPath := path + ".xmp"
-
-	if scanner_utils.FileExists(testPath) {
-		return &testPath
-	}
-
-	return nil
-}
-
 func hashSideCarFile(path *string) *string {
 	if path == nil {
 		return nil
 	}
 
-	f, err := os.Open(*path)
+	content, err := os.ReadFile(*path)
 	if err != nil {
 		log.Printf("ERROR: %s", err)
 	}
-	defer f.Close()
 
-	h := md5.New()
-	if _, err := io.Copy(h, f); err != nil {
-		log.Printf("ERROR: %s", err)
-	}
-	hash := hex.EncodeToString(h.Sum(nil))
+	hash := exif.HashXMPSidecar(content)
 	return &hash
 }
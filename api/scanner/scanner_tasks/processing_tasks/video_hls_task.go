@@ -0,0 +1,155 @@
+package processing_tasks
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/media_encoding"
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/photoview/photoview/api/scanner/scanner_task"
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// VideoHLSTask encodes videos as an adaptive HLS stream, when enabled by the `PHOTOVIEW_ENABLE_HLS` environment variable.
+// The stream is stored as a directory in the media cache, holding the master playlist and a media playlist and segments per rendition.
+type VideoHLSTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t VideoHLSTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
+	if mediaData.Media.Type != models.MediaTypeVideo || !utils.EnvEnableHLS.GetBool() || !executable_worker.FfmpegCli.IsInstalled() {
+		return []*models.MediaURL{}, nil
+	}
+
+	video := mediaData.Media
+
+	hlsURL, err := makePhotoURLChecker(ctx.GetDB(), video.ID)(models.VideoHLS)
+	if err != nil {
+		return []*models.MediaURL{}, errors.Wrap(err, "error processing video HLS stream")
+	}
+
+	if hlsURL != nil {
+		// Verify that the stream still exists in cache
+		masterPath := path.Join(mediaCachePath, hlsURL.MediaName, models.HLSMasterPlaylist)
+		if _, err := os.Stat(masterPath); !os.IsNotExist(err) {
+			return []*models.MediaURL{}, nil
+		}
+
+		fmt.Printf("HLS stream found in database but not in cache, re-encoding video to cache: %s\n", hlsURL.MediaName)
+	}
+
+	probeData, err := mediaData.VideoMetadata()
+	if err != nil {
+		return []*models.MediaURL{}, err
+	}
+
+	videoStream := probeData.FirstVideoStream()
+	if videoStream == nil {
+		return []*models.MediaURL{}, errors.Errorf("could not get video stream of video (%s)", video.Path)
+	}
+
+	width, height := media_utils.GetVideoDisplayDimensions(videoStream)
+	renditions := executable_worker.SelectHLSRenditions(executable_worker.DefaultHLSLadder, width, height)
+	if len(renditions) == 0 {
+		log.Printf("Skipping HLS stream, as the video is smaller than the lowest rendition: %s\n", video.Path)
+		return []*models.MediaURL{}, nil
+	}
+
+	var hlsName string
+	if hlsURL != nil {
+		hlsName = hlsURL.MediaName
+	} else {
+		hlsName = generateUniqueMediaNamePrefixed("hls", video.Path, "")
+	}
+
+	hlsPath := path.Join(mediaCachePath, hlsName)
+
+	// Start from an empty directory, to not mix segments of an interrupted encoding with the new ones
+	if err := os.RemoveAll(hlsPath); err != nil {
+		return []*models.MediaURL{}, errors.Wrapf(err, "could not remove old HLS stream (%s)", hlsPath)
+	}
+
+	if err := os.Mkdir(hlsPath, 0755); err != nil {
+		return []*models.MediaURL{}, errors.Wrapf(err, "could not create directory for HLS stream (%s)", hlsPath)
+	}
+
+	variants := make([]executable_worker.HLSVariant, 0, len(renditions))
+	for _, rendition := range renditions {
+		if err := executable_worker.FfmpegCli.EncodeHLSRendition(video.Path, hlsPath, rendition); err != nil {
+			return []*models.MediaURL{}, errors.Wrapf(err, "could not encode HLS stream (%s)", video.Path)
+		}
+
+		variant := executable_worker.HLSVariant{
+			Rendition: rendition,
+		}
+
+		// The resolution is optional in the master playlist, so it is left out if it can not be read
+		if stream, err := ReadVideoStreamMetadata(path.Join(hlsPath, rendition.PlaylistName())); err != nil {
+			log.Printf("WARN: could not read dimensions of HLS rendition %s (%s): %s\n", rendition.Name, video.Path, err)
+		} else {
+			variant.Width, variant.Height = media_utils.GetVideoDisplayDimensions(stream)
+		}
+
+		variants = append(variants, variant)
+	}
+
+	// The master playlist is written last, as its existence marks the stream as complete
+	if err := executable_worker.WriteHLSMasterPlaylist(path.Join(hlsPath, models.HLSMasterPlaylist), variants); err != nil {
+		return []*models.MediaURL{}, err
+	}
+
+	updatedURL, err := saveHLSStreamToDB(ctx.GetDB(), video, hlsName, hlsPath, variants[len(variants)-1], hlsURL)
+	if err != nil {
+		return []*models.MediaURL{}, err
+	}
+
+	return []*models.MediaURL{updatedURL}, nil
+}
+
+func saveHLSStreamToDB(tx *gorm.DB, video *models.Media, hlsName string, hlsPath string, largestVariant executable_worker.HLSVariant, mediaURL *models.MediaURL) (*models.MediaURL, error) {
+	var fileSize int64
+	err := filepath.Walk(hlsPath, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		fileSize += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of HLS stream")
+	}
+
+	if mediaURL == nil {
+		mediaURL = &models.MediaURL{
+			MediaID:     video.ID,
+			MediaName:   hlsName,
+			Width:       largestVariant.Width,
+			Height:      largestVariant.Height,
+			Purpose:     models.VideoHLS,
+			ContentType: "application/vnd.apple.mpegurl",
+			FileSize:    fileSize,
+		}
+
+		if err := tx.Create(&mediaURL).Error; err != nil {
+			return nil, errors.Wrapf(err, "could not insert HLS stream media url (%d, %s)", video.ID, hlsName)
+		}
+	} else {
+		mediaURL.Width = largestVariant.Width
+		mediaURL.Height = largestVariant.Height
+		mediaURL.FileSize = fileSize
+
+		if err := tx.Save(&mediaURL).Error; err != nil {
+			return nil, errors.Wrapf(err, "could not update HLS stream media url (%d, %s)", video.ID, hlsName)
+		}
+	}
+
+	return mediaURL, nil
+}
@@ -0,0 +1,98 @@
+package processing_tasks
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/media_encoding"
+	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
+	"github.com/photoview/photoview/api/scanner/scanner_task"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// VideoPreviewTask generates a short animated preview of videos, to be played when hovering over them in grid views
+type VideoPreviewTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t VideoPreviewTask) ProcessMedia(ctx scanner_task.TaskContext, mediaData *media_encoding.EncodeMediaData, mediaCachePath string) ([]*models.MediaURL, error) {
+	if mediaData.Media.Type != models.MediaTypeVideo || !executable_worker.FfmpegCli.IsInstalled() {
+		return []*models.MediaURL{}, nil
+	}
+
+	video := mediaData.Media
+
+	previewURL, err := makePhotoURLChecker(ctx.GetDB(), video.ID)(models.VideoPreview)
+	if err != nil {
+		return []*models.MediaURL{}, errors.Wrap(err, "error processing video preview")
+	}
+
+	if previewURL != nil {
+		// Verify that video preview still exists in cache
+		previewPath := path.Join(mediaCachePath, previewURL.MediaName)
+		if _, err := os.Stat(previewPath); !os.IsNotExist(err) {
+			return []*models.MediaURL{}, nil
+		}
+
+		fmt.Printf("Video preview found in database but not in cache, re-encoding video to cache: %s\n", previewURL.MediaName)
+	}
+
+	probeData, err := mediaData.VideoMetadata()
+	if err != nil {
+		return []*models.MediaURL{}, err
+	}
+
+	var previewName string
+	if previewURL != nil {
+		previewName = previewURL.MediaName
+	} else {
+		previewName = generateUniqueMediaNamePrefixed("video_preview", video.Path, ".mp4")
+	}
+
+	previewPath := path.Join(mediaCachePath, previewName)
+
+	if err := executable_worker.FfmpegCli.EncodeVideoPreview(video.Path, previewPath, probeData); err != nil {
+		return []*models.MediaURL{}, errors.Wrapf(err, "failed to generate preview for video (%s)", video.Title)
+	}
+
+	updatedURL, err := saveVideoPreviewToDB(ctx.GetDB(), video, previewName, previewPath, previewURL)
+	if err != nil {
+		return []*models.MediaURL{}, err
+	}
+
+	return []*models.MediaURL{updatedURL}, nil
+}
+
+func saveVideoPreviewToDB(tx *gorm.DB, video *models.Media, previewName string, previewPath string, mediaURL *models.MediaURL) (*models.MediaURL, error) {
+	previewMetadata, err := ReadVideoStreamMetadata(previewPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read metadata for video preview (%s)", video.Title)
+	}
+
+	fileStats, err := os.Stat(previewPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file stats of video preview")
+	}
+
+	if mediaURL == nil {
+		mediaURL = &models.MediaURL{
+			MediaID:     video.ID,
+			MediaName:   previewName,
+			Purpose:     models.VideoPreview,
+			ContentType: "video/mp4",
+		}
+	}
+
+	mediaURL.Width, mediaURL.Height = media_utils.GetVideoDisplayDimensions(previewMetadata)
+	mediaURL.FileSize = fileStats.Size()
+
+	if err := tx.Save(mediaURL).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to save video preview to database (%s)", video.Title)
+	}
+
+	return mediaURL, nil
+}
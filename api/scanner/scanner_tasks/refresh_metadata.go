@@ -0,0 +1,40 @@
+package scanner_tasks
+
+import (
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// RefreshMediaMetadata reads the metadata of an existing media again, as the scanner only reads it for new media.
+// The stored metadata is replaced, while the dates and locations overridden by users are kept.
+func RefreshMediaMetadata(tx *gorm.DB, media *models.Media) error {
+	if _, err := exif.ReimportEXIF(tx, media); err != nil {
+		return errors.Wrapf(err, "import metadata of %s", media.Path)
+	}
+
+	saveDocumentMetadata(tx, media)
+
+	if media.Type != models.MediaTypeVideo {
+		return nil
+	}
+
+	previousMetadataID, previousMetadata := media.VideoMetadataID, media.VideoMetadata
+	media.VideoMetadataID = nil
+	media.VideoMetadata = nil
+
+	if err := ScanVideoMetadata(tx, media); err != nil {
+		media.VideoMetadataID, media.VideoMetadata = previousMetadataID, previousMetadata
+		return err
+	}
+
+	// The video metadata is saved as a new row, the previous one and its streams are not used anymore
+	if previousMetadataID != nil {
+		if err := tx.Delete(&models.VideoMetadata{}, *previousMetadataID).Error; err != nil {
+			return errors.Wrapf(err, "delete previous video metadata of %s", media.Path)
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/photoview/photoview/api/scanner/scanner_task"
 	"github.com/photoview/photoview/api/scanner/scanner_tasks/cleanup_tasks"
 	"github.com/photoview/photoview/api/scanner/scanner_tasks/processing_tasks"
+	"github.com/photoview/photoview/api/scanner/scanner_tasks/stack_tasks"
+	"github.com/photoview/photoview/api/scanner/scanner_tasks/version_tasks"
 )
 
 var allTasks []scanner_task.ScannerTask = []scanner_task.ScannerTask{
@@ -17,10 +19,16 @@ var allTasks []scanner_task.ScannerTask = []scanner_task.ScannerTask{
 	processing_tasks.SidecarTask{},
 	processing_tasks.ProcessPhotoTask{},
 	processing_tasks.ProcessVideoTask{},
+	processing_tasks.VideoHLSTask{},
+	processing_tasks.VideoPreviewTask{},
+	processing_tasks.MotionPhotoTask{},
 	FaceDetectionTask{},
 	ExifTask{},
 	VideoMetadataTask{},
+	GPXTask{},
 	cleanup_tasks.MediaCleanupTask{},
+	stack_tasks.MediaStackTask{},
+	version_tasks.EditedVersionsTask{},
 }
 
 type scannerTasks struct {
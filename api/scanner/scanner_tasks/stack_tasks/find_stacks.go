@@ -0,0 +1,265 @@
+package stack_tasks
+
+import (
+	"math"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+)
+
+// MediaStackGroup is a set of photos found to belong to the same stack
+type MediaStackGroup struct {
+	Kind  models.MediaStackKind
+	Key   string
+	Media []*models.Media
+	Cover *models.Media
+}
+
+// maxBracketInterval is the longest time allowed between two shots of the same exposure bracket
+const maxBracketInterval = 2 * time.Second
+
+// sequenceFilename matches filenames ending with a counter, such as IMG_0042.JPG
+var sequenceFilename = regexp.MustCompile(`^(.*?)(\d+)(\.[^.]*)?$`)
+
+// FindMediaStacks groups the photos of an album into burst sequences and exposure brackets.
+// The EXIF metadata of the media must be loaded.
+func FindMediaStacks(media []*models.Media) []*MediaStackGroup {
+	photos := make([]*models.Media, 0, len(media))
+	for _, m := range media {
+		if m.Type == models.MediaTypePhoto {
+			photos = append(photos, m)
+		}
+	}
+
+	burstGroups, photos := findBurstIDStacks(photos)
+	bracketGroups, photos := findBracketStacks(photos)
+	sequenceGroups, _ := findSequenceStacks(photos)
+
+	groups := make([]*MediaStackGroup, 0, len(burstGroups)+len(bracketGroups)+len(sequenceGroups))
+	groups = append(groups, burstGroups...)
+	groups = append(groups, bracketGroups...)
+	groups = append(groups, sequenceGroups...)
+	return groups
+}
+
+// findBurstIDStacks groups photos sharing the same burst id, the first frame becomes the cover
+func findBurstIDStacks(photos []*models.Media) (groups []*MediaStackGroup, ungrouped []*models.Media) {
+	bursts := make(map[string][]*models.Media)
+	burstIDs := make([]string, 0)
+
+	for _, photo := range photos {
+		if photo.Exif == nil || photo.Exif.BurstID == nil {
+			ungrouped = append(ungrouped, photo)
+			continue
+		}
+
+		burstID := *photo.Exif.BurstID
+		if _, found := bursts[burstID]; !found {
+			burstIDs = append(burstIDs, burstID)
+		}
+		bursts[burstID] = append(bursts[burstID], photo)
+	}
+
+	for _, burstID := range burstIDs {
+		frames := bursts[burstID]
+		if len(frames) < 2 {
+			ungrouped = append(ungrouped, frames...)
+			continue
+		}
+
+		sortByCapture(frames)
+		groups = append(groups, &MediaStackGroup{
+			Kind:  models.MediaStackKindBurst,
+			Key:   "burst:" + burstID,
+			Media: frames,
+			Cover: frames[0],
+		})
+	}
+
+	return groups, ungrouped
+}
+
+// findBracketStacks groups consecutive shots of an auto exposure bracket,
+// the shot closest to the metered exposure becomes the cover
+func findBracketStacks(photos []*models.Media) (groups []*MediaStackGroup, ungrouped []*models.Media) {
+	candidates := make([]*models.Media, 0)
+	for _, photo := range photos {
+		if photo.Exif == nil || photo.Exif.BracketValue == nil {
+			ungrouped = append(ungrouped, photo)
+			continue
+		}
+		candidates = append(candidates, photo)
+	}
+
+	sortByCapture(candidates)
+
+	bracket := make([]*models.Media, 0)
+	flush := func() {
+		if len(bracket) < 2 {
+			ungrouped = append(ungrouped, bracket...)
+			return
+		}
+
+		cover := bracket[0]
+		for _, shot := range bracket[1:] {
+			if math.Abs(*shot.Exif.BracketValue) < math.Abs(*cover.Exif.BracketValue) {
+				cover = shot
+			}
+		}
+
+		groups = append(groups, &MediaStackGroup{
+			Kind:  models.MediaStackKindBracket,
+			Key:   "bracket:" + models.MD5Hash(bracket[0].Path),
+			Media: bracket,
+			Cover: cover,
+		})
+	}
+
+	for _, photo := range candidates {
+		if len(bracket) > 0 && !continuesBracket(bracket, photo) {
+			flush()
+			bracket = make([]*models.Media, 0)
+		}
+		bracket = append(bracket, photo)
+	}
+	flush()
+
+	return groups, ungrouped
+}
+
+func continuesBracket(bracket []*models.Media, photo *models.Media) bool {
+	previous := bracket[len(bracket)-1]
+
+	if !equalStrings(previous.Exif.Camera, photo.Exif.Camera) {
+		return false
+	}
+
+	if photo.DateShot.Sub(previous.DateShot) > maxBracketInterval {
+		return false
+	}
+
+	// Cameras restart the sequence number for every bracket
+	if previous.Exif.SequenceNumber != nil && photo.Exif.SequenceNumber != nil &&
+		*photo.Exif.SequenceNumber < *previous.Exif.SequenceNumber {
+		return false
+	}
+
+	// A bracket never repeats an exposure, so a repeated value marks the start of the next bracket
+	for _, shot := range bracket {
+		if *shot.Exif.BracketValue == *photo.Exif.BracketValue {
+			return false
+		}
+	}
+
+	return true
+}
+
+type sequenceFrame struct {
+	media  *models.Media
+	prefix string
+	number int
+	ext    string
+}
+
+// findSequenceStacks groups photos with consecutively numbered filenames taken at the exact same time,
+// as written by cameras not recording a burst id, the first frame becomes the cover
+func findSequenceStacks(photos []*models.Media) (groups []*MediaStackGroup, ungrouped []*models.Media) {
+	frames := make([]sequenceFrame, 0)
+	for _, photo := range photos {
+		match := sequenceFilename.FindStringSubmatch(path.Base(photo.Path))
+		if photo.Exif == nil || photo.Exif.DateShot == nil || match == nil {
+			ungrouped = append(ungrouped, photo)
+			continue
+		}
+
+		number, err := strconv.Atoi(match[2])
+		if err != nil {
+			ungrouped = append(ungrouped, photo)
+			continue
+		}
+
+		frames = append(frames, sequenceFrame{
+			media:  photo,
+			prefix: match[1],
+			number: number,
+			ext:    strings.ToLower(match[3]),
+		})
+	}
+
+	sort.SliceStable(frames, func(i, j int) bool {
+		if frames[i].prefix != frames[j].prefix {
+			return frames[i].prefix < frames[j].prefix
+		}
+		return frames[i].number < frames[j].number
+	})
+
+	sequence := make([]sequenceFrame, 0)
+	flush := func() {
+		if len(sequence) < 2 {
+			for _, frame := range sequence {
+				ungrouped = append(ungrouped, frame.media)
+			}
+			return
+		}
+
+		media := make([]*models.Media, len(sequence))
+		for i, frame := range sequence {
+			media[i] = frame.media
+		}
+
+		groups = append(groups, &MediaStackGroup{
+			Kind:  models.MediaStackKindBurst,
+			Key:   "sequence:" + models.MD5Hash(media[0].Path),
+			Media: media,
+			Cover: media[0],
+		})
+	}
+
+	for _, frame := range frames {
+		if len(sequence) > 0 {
+			previous := sequence[len(sequence)-1]
+			continues := frame.prefix == previous.prefix &&
+				frame.ext == previous.ext &&
+				frame.number == previous.number+1 &&
+				frame.media.Exif.DateShot.Equal(*previous.media.Exif.DateShot)
+
+			if !continues {
+				flush()
+				sequence = make([]sequenceFrame, 0)
+			}
+		}
+		sequence = append(sequence, frame)
+	}
+	flush()
+
+	return groups, ungrouped
+}
+
+// sortByCapture orders the media by the time they were taken, then by their sequence number and filename
+func sortByCapture(media []*models.Media) {
+	sort.SliceStable(media, func(i, j int) bool {
+		a, b := media[i], media[j]
+		if !a.DateShot.Equal(b.DateShot) {
+			return a.DateShot.Before(b.DateShot)
+		}
+
+		if a.Exif != nil && b.Exif != nil && a.Exif.SequenceNumber != nil && b.Exif.SequenceNumber != nil &&
+			*a.Exif.SequenceNumber != *b.Exif.SequenceNumber {
+			return *a.Exif.SequenceNumber < *b.Exif.SequenceNumber
+		}
+
+		return path.Base(a.Path) < path.Base(b.Path)
+	})
+}
+
+func equalStrings(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,30 @@
+package stack_tasks
+
+import (
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/scanner_task"
+	"github.com/photoview/photoview/api/scanner/scanner_utils"
+)
+
+// MediaStackTask groups burst sequences and exposure brackets of the album into stacks,
+// once the EXIF metadata of all media has been read
+type MediaStackTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t MediaStackTask) AfterScanAlbum(ctx scanner_task.TaskContext, changedMedia []*models.Media, albumMedia []*models.Media) error {
+	db := ctx.GetDB()
+	albumID := ctx.GetAlbum().ID
+
+	var photos []*models.Media
+	if err := db.Preload("Exif").Where("album_id = ? AND type = ?", albumID, models.MediaTypePhoto).Find(&photos).Error; err != nil {
+		scanner_utils.ScannerError("get photos to stack: %s", err)
+		return nil
+	}
+
+	if err := SaveMediaStacks(db, albumID, FindMediaStacks(photos)); err != nil {
+		scanner_utils.ScannerError("save media stacks: %s", err)
+	}
+
+	return nil
+}
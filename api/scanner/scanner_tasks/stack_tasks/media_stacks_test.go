@@ -0,0 +1,132 @@
+package stack_tasks_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/scanner_tasks/stack_tasks"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(test_utils.IntegrationTestRun(m))
+}
+
+func testPhoto(path string, date time.Time, exif models.MediaEXIF) *models.Media {
+	exif.DateShot = &date
+	return &models.Media{
+		Title:    path,
+		Path:     "/photos/" + path,
+		Type:     models.MediaTypePhoto,
+		DateShot: date,
+		Exif:     &exif,
+	}
+}
+
+func stackTitles(group *stack_tasks.MediaStackGroup) []string {
+	titles := make([]string, len(group.Media))
+	for i, media := range group.Media {
+		titles[i] = media.Title
+	}
+	return titles
+}
+
+func TestFindMediaStacks(t *testing.T) {
+	date := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	burstID := "8A2C5C76-3D1B-4E2B-9F5A-0C6E7A1D2B3C"
+	camera := "Canon EOS R6"
+	bracketValue := func(value float64) *float64 { return &value }
+
+	media := []*models.Media{
+		testPhoto("IMG_1002.HEIC", date.Add(time.Second), models.MediaEXIF{BurstID: &burstID}),
+		testPhoto("IMG_1001.HEIC", date, models.MediaEXIF{BurstID: &burstID}),
+
+		testPhoto("HDR_0011.JPG", date.Add(time.Minute+time.Second), models.MediaEXIF{Camera: &camera, BracketValue: bracketValue(-2)}),
+		testPhoto("HDR_0010.JPG", date.Add(time.Minute), models.MediaEXIF{Camera: &camera, BracketValue: bracketValue(0)}),
+		testPhoto("HDR_0012.JPG", date.Add(time.Minute+time.Second), models.MediaEXIF{Camera: &camera, BracketValue: bracketValue(2)}),
+		// Starts the next bracket, as the exposure repeats
+		testPhoto("HDR_0013.JPG", date.Add(time.Minute+2*time.Second), models.MediaEXIF{Camera: &camera, BracketValue: bracketValue(0)}),
+
+		testPhoto("DSC_0100.JPG", date.Add(time.Hour), models.MediaEXIF{}),
+		testPhoto("DSC_0101.JPG", date.Add(time.Hour), models.MediaEXIF{}),
+		testPhoto("DSC_0102.JPG", date.Add(time.Hour), models.MediaEXIF{}),
+		// Taken a second later, so not part of the sequence
+		testPhoto("DSC_0103.JPG", date.Add(time.Hour+time.Second), models.MediaEXIF{}),
+
+		{Title: "clip.mp4", Path: "/photos/clip.mp4", Type: models.MediaTypeVideo, DateShot: date},
+	}
+
+	groups := stack_tasks.FindMediaStacks(media)
+	if !assert.Len(t, groups, 3) {
+		return
+	}
+
+	assert.Equal(t, models.MediaStackKindBurst, groups[0].Kind)
+	assert.Equal(t, "burst:"+burstID, groups[0].Key)
+	assert.Equal(t, []string{"IMG_1001.HEIC", "IMG_1002.HEIC"}, stackTitles(groups[0]))
+	assert.Equal(t, "IMG_1001.HEIC", groups[0].Cover.Title)
+
+	assert.Equal(t, models.MediaStackKindBracket, groups[1].Kind)
+	assert.Equal(t, []string{"HDR_0010.JPG", "HDR_0011.JPG", "HDR_0012.JPG"}, stackTitles(groups[1]))
+	assert.Equal(t, "HDR_0010.JPG", groups[1].Cover.Title)
+
+	assert.Equal(t, models.MediaStackKindBurst, groups[2].Kind)
+	assert.Equal(t, []string{"DSC_0100.JPG", "DSC_0101.JPG", "DSC_0102.JPG"}, stackTitles(groups[2]))
+	assert.Equal(t, "DSC_0100.JPG", groups[2].Cover.Title)
+}
+
+func TestSaveMediaStacks(t *testing.T) {
+	db := test_utils.DatabaseTest(t)
+
+	album := models.Album{
+		Title: "album",
+		Path:  "/photos",
+	}
+	if !assert.NoError(t, db.Save(&album).Error) {
+		return
+	}
+
+	date := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	media := []*models.Media{
+		testPhoto("DSC_0100.JPG", date, models.MediaEXIF{}),
+		testPhoto("DSC_0101.JPG", date, models.MediaEXIF{}),
+		testPhoto("DSC_0102.JPG", date, models.MediaEXIF{}),
+	}
+	for _, m := range media {
+		m.AlbumID = album.ID
+	}
+	if !assert.NoError(t, db.Save(&media).Error) {
+		return
+	}
+
+	groups := stack_tasks.FindMediaStacks(media)
+	if !assert.NoError(t, stack_tasks.SaveMediaStacks(db, album.ID, groups)) {
+		return
+	}
+
+	var stacks []*models.MediaStack
+	assert.NoError(t, db.Find(&stacks).Error)
+	if !assert.Len(t, stacks, 1) {
+		return
+	}
+	assert.Equal(t, media[0].ID, stacks[0].CoverID)
+
+	var stackedCount int64
+	assert.NoError(t, db.Model(&models.Media{}).Where("stack_id = ?", stacks[0].ID).Count(&stackedCount).Error)
+	assert.EqualValues(t, 3, stackedCount)
+
+	// Saving the same groups again keeps the stack, saving no groups removes it
+	assert.NoError(t, stack_tasks.SaveMediaStacks(db, album.ID, groups))
+	var sameStack models.MediaStack
+	assert.NoError(t, db.First(&sameStack).Error)
+	assert.Equal(t, stacks[0].ID, sameStack.ID)
+
+	assert.NoError(t, stack_tasks.SaveMediaStacks(db, album.ID, nil))
+	assert.NoError(t, db.Model(&models.Media{}).Where("stack_id IS NOT NULL").Count(&stackedCount).Error)
+	assert.EqualValues(t, 0, stackedCount)
+	assert.NoError(t, db.Model(&models.MediaStack{}).Count(&stackedCount).Error)
+	assert.EqualValues(t, 0, stackedCount)
+}
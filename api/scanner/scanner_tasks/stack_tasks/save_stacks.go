@@ -0,0 +1,75 @@
+package stack_tasks
+
+import (
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// SaveMediaStacks replaces the stacks of an album with the given groups.
+// Stacks found again keep their id, media no longer part of any group are removed from their stack.
+func SaveMediaStacks(db *gorm.DB, albumID int, groups []*MediaStackGroup) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var existingStacks []*models.MediaStack
+		if err := tx.Where("album_id = ?", albumID).Find(&existingStacks).Error; err != nil {
+			return errors.Wrap(err, "get existing media stacks of album")
+		}
+
+		stacksByKey := make(map[string]*models.MediaStack, len(existingStacks))
+		for _, stack := range existingStacks {
+			stacksByKey[stack.Key] = stack
+		}
+
+		stackIDs := make([]int, 0, len(groups))
+		stackedMediaIDs := make([]int, 0)
+
+		for _, group := range groups {
+			stack, found := stacksByKey[group.Key]
+			if !found {
+				stack = &models.MediaStack{
+					AlbumID: albumID,
+					Key:     group.Key,
+				}
+			}
+
+			stack.Kind = group.Kind
+			stack.CoverID = group.Cover.ID
+
+			if err := tx.Save(stack).Error; err != nil {
+				return errors.Wrapf(err, "save media stack (%s)", group.Key)
+			}
+
+			mediaIDs := make([]int, len(group.Media))
+			for i, media := range group.Media {
+				mediaIDs[i] = media.ID
+			}
+
+			if err := tx.Model(&models.Media{}).Where("id IN (?)", mediaIDs).UpdateColumn("stack_id", stack.ID).Error; err != nil {
+				return errors.Wrapf(err, "add media to stack (%s)", group.Key)
+			}
+
+			stackIDs = append(stackIDs, stack.ID)
+			stackedMediaIDs = append(stackedMediaIDs, mediaIDs...)
+		}
+
+		unstackQuery := tx.Model(&models.Media{}).Where("album_id = ? AND stack_id IS NOT NULL", albumID)
+		if len(stackedMediaIDs) > 0 {
+			unstackQuery = unstackQuery.Where("id NOT IN (?)", stackedMediaIDs)
+		}
+
+		if err := unstackQuery.UpdateColumn("stack_id", nil).Error; err != nil {
+			return errors.Wrap(err, "remove media from old stacks")
+		}
+
+		deleteQuery := tx.Where("album_id = ?", albumID)
+		if len(stackIDs) > 0 {
+			deleteQuery = deleteQuery.Where("id NOT IN (?)", stackIDs)
+		}
+
+		if err := deleteQuery.Delete(&models.MediaStack{}).Error; err != nil {
+			return errors.Wrap(err, "delete old media stacks")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,52 @@
+package version_tasks
+
+import (
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/scanner_task"
+	"github.com/photoview/photoview/api/scanner/scanner_utils"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// EditedVersionsTask links the edited copies of the album to their originals
+type EditedVersionsTask struct {
+	scanner_task.ScannerTaskBase
+}
+
+func (t EditedVersionsTask) AfterScanAlbum(ctx scanner_task.TaskContext, changedMedia []*models.Media, albumMedia []*models.Media) error {
+	if err := LinkEditedVersions(ctx.GetDB(), ctx.GetAlbum().ID); err != nil {
+		scanner_utils.ScannerError("link edited versions: %s", err)
+	}
+
+	return nil
+}
+
+// LinkEditedVersions updates the originals of all media in the album
+func LinkEditedVersions(db *gorm.DB, albumID int) error {
+	var media []*models.Media
+	if err := db.Where("album_id = ?", albumID).Find(&media).Error; err != nil {
+		return errors.Wrap(err, "get media of album")
+	}
+
+	originals := FindOriginals(media, EditedFilenamePattern())
+
+	for _, m := range media {
+		var originalID *int
+		if id, found := originals[m.ID]; found {
+			originalID = &id
+		}
+
+		if originalID == nil && m.OriginalMediaID == nil {
+			continue
+		}
+		if originalID != nil && m.OriginalMediaID != nil && *originalID == *m.OriginalMediaID {
+			continue
+		}
+
+		if err := db.Model(m).UpdateColumn("original_media_id", originalID).Error; err != nil {
+			return errors.Wrapf(err, "update original of media (%s)", m.Path)
+		}
+	}
+
+	return nil
+}
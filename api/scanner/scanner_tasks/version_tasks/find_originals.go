@@ -0,0 +1,106 @@
+package version_tasks
+
+import (
+	"log"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/utils"
+)
+
+// defaultEditedFilenamePattern matches copies exported by editors, like IMG_1234-edited, IMG_1234 (1) and IMG_1234_v2
+const defaultEditedFilenamePattern = `(?i)(-edited| \(\d+\)|_v\d+)`
+
+// EditedFilenamePattern returns the configured pattern matching the end of the filenames of edited copies
+func EditedFilenamePattern() *regexp.Regexp {
+	if pattern := utils.EnvEditedFilenamePattern.GetValue(); pattern != "" {
+		compiled, err := regexp.Compile("(?:" + pattern + ")$")
+		if err == nil {
+			return compiled
+		}
+
+		log.Printf("WARN: invalid %s, using the default pattern instead: %s\n", utils.EnvEditedFilenamePattern.GetName(), err)
+	}
+
+	return regexp.MustCompile("(?:" + defaultEditedFilenamePattern + ")$")
+}
+
+// FindOriginals links the edited copies among the media of an album to their originals,
+// using the XMP document ids written by editors, or otherwise the filenames.
+// The result maps the id of every edited copy to the id of the original at the root of its edits.
+func FindOriginals(media []*models.Media, editedPattern *regexp.Regexp) map[int]int {
+	sorted := make([]*models.Media, len(media))
+	copy(sorted, media)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	byName := make(map[string]*models.Media)
+	byDocumentID := make(map[string]*models.Media)
+	for _, m := range sorted {
+		name := strings.ToLower(filenameStem(m.Path))
+		if _, found := byName[name]; !found {
+			byName[name] = m
+		}
+
+		if m.DocumentID != nil {
+			if _, found := byDocumentID[*m.DocumentID]; !found {
+				byDocumentID[*m.DocumentID] = m
+			}
+		}
+	}
+
+	originals := make(map[int]*models.Media)
+	for _, m := range sorted {
+		if m.DerivedFromDocumentID != nil {
+			if original, found := byDocumentID[*m.DerivedFromDocumentID]; found && original != m && original.Type == m.Type {
+				originals[m.ID] = original
+				continue
+			}
+		}
+
+		// Strip the edit markers one at a time, so copies of copies are linked to the first original found
+		name := filenameStem(m.Path)
+		for {
+			location := editedPattern.FindStringIndex(name)
+			if location == nil || location[0] == 0 {
+				break
+			}
+
+			name = name[:location[0]]
+			if original, found := byName[strings.ToLower(name)]; found && original != m && original.Type == m.Type {
+				originals[m.ID] = original
+				break
+			}
+		}
+	}
+
+	// Follow the links to the root original, so all versions of a photo share it
+	result := make(map[int]int, len(originals))
+	for mediaID, original := range originals {
+		visited := map[int]bool{mediaID: true}
+		for {
+			next, found := originals[original.ID]
+			if !found || visited[original.ID] {
+				break
+			}
+			visited[original.ID] = true
+			original = next
+		}
+
+		if original.ID != mediaID {
+			result[mediaID] = original.ID
+		}
+	}
+
+	return result
+}
+
+// filenameStem returns the filename of the path without the extension
+func filenameStem(filePath string) string {
+	filename := path.Base(filePath)
+	return strings.TrimSuffix(filename, path.Ext(filename))
+}
@@ -0,0 +1,40 @@
+package version_tasks_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/scanner_tasks/version_tasks"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(test_utils.UnitTestRun(m))
+}
+
+func TestFindOriginals(t *testing.T) {
+	documentID := "xmp.did:1234"
+
+	media := []*models.Media{
+		{Model: models.Model{ID: 1}, Path: "/photos/IMG_1234.HEIC", Type: models.MediaTypePhoto},
+		{Model: models.Model{ID: 2}, Path: "/photos/IMG_1234-edited.jpg", Type: models.MediaTypePhoto},
+		{Model: models.Model{ID: 3}, Path: "/photos/IMG_1234-edited (1).jpg", Type: models.MediaTypePhoto},
+		{Model: models.Model{ID: 4}, Path: "/photos/IMG_1234_v2.jpg", Type: models.MediaTypePhoto},
+		// A video with the same name is not a version of the photo
+		{Model: models.Model{ID: 5}, Path: "/photos/IMG_1234 (1).mov", Type: models.MediaTypeVideo},
+		{Model: models.Model{ID: 6}, Path: "/photos/DSC_0001.NEF", Type: models.MediaTypePhoto, DocumentID: &documentID},
+		{Model: models.Model{ID: 7}, Path: "/photos/sunset.jpg", Type: models.MediaTypePhoto, DerivedFromDocumentID: &documentID},
+		{Model: models.Model{ID: 8}, Path: "/photos/beach_v2.jpg", Type: models.MediaTypePhoto},
+	}
+
+	originals := version_tasks.FindOriginals(media, version_tasks.EditedFilenamePattern())
+
+	assert.Equal(t, map[int]int{
+		2: 1,
+		3: 1,
+		4: 1,
+		7: 6,
+	}, originals)
+}
@@ -7,9 +7,12 @@ import (
 	"strings"
 
 	"github.com/photoview/photoview/api/graphql/models"
+	"github.com/photoview/photoview/api/scanner/exif"
+	"github.com/photoview/photoview/api/scanner/media_encoding/media_utils"
 	"github.com/photoview/photoview/api/scanner/scanner_task"
 	"github.com/photoview/photoview/api/scanner/scanner_tasks/processing_tasks"
 	"github.com/pkg/errors"
+	"gopkg.in/vansante/go-ffprobe.v2"
 	"gorm.io/gorm"
 )
 
@@ -38,6 +41,14 @@ func ScanVideoMetadata(tx *gorm.DB, video *models.Media) error {
 		return errors.Wrapf(err, "scan video metadata failed (%s)", video.Title)
 	}
 
+	if err := exif.SaveVideoContainerExif(tx, video, data); err != nil {
+		log.Printf("WARN: reading capture date and location of video %s failed: %s\n", video.Title, err)
+	}
+
+	if err := exif.SavePanorama(tx, video, exif.ParseVideoPanorama(data)); err != nil {
+		log.Printf("WARN: saving spherical metadata of video %s failed: %s\n", video.Title, err)
+	}
+
 	stream := data.FirstVideoStream()
 	if stream == nil {
 		return errors.New(fmt.Sprintf("could not get video stream from metadata (%s)", video.Path))
@@ -73,15 +84,34 @@ func ScanVideoMetadata(tx *gorm.DB, video *models.Media) error {
 		}
 	}
 
+	displayWidth, displayHeight := media_utils.GetVideoDisplayDimensions(stream)
+
 	videoMetadata := models.VideoMetadata{
-		Width:        stream.Width,
-		Height:       stream.Height,
-		Duration:     data.Format.DurationSeconds,
-		Codec:        &stream.CodecLongName,
-		Framerate:    framerate,
-		Bitrate:      &stream.BitRate,
-		ColorProfile: &stream.Profile,
-		Audio:        &audioText,
+		Width:           stream.Width,
+		Height:          stream.Height,
+		Duration:        data.Format.DurationSeconds,
+		Codec:           &stream.CodecLongName,
+		Framerate:       framerate,
+		Bitrate:         &stream.BitRate,
+		ColorProfile:    &stream.Profile,
+		Audio:           &audioText,
+		Rotation:        media_utils.GetVideoRotation(stream),
+		DisplayWidth:    &displayWidth,
+		DisplayHeight:   &displayHeight,
+		ContainerFormat: optionalString(data.Format.FormatLongName),
+		Streams:         parseVideoStreams(data),
+		Chapters:        parseVideoChapters(data),
+	}
+
+	if displayMatrix, err := stream.SideDataList.GetDisplayMatrix(); err == nil {
+		videoMetadata.DisplayMatrix = optionalString(strings.TrimSpace(displayMatrix.Data))
+	}
+
+	if colorInfo, err := processing_tasks.ReadVideoColorInfo(video.Path); err != nil {
+		log.Printf("WARN: reading color info of video %s failed: %s\n", video.Title, err)
+	} else {
+		videoMetadata.ColorTransfer = optionalString(colorInfo.ColorTransfer)
+		videoMetadata.ColorPrimaries = optionalString(colorInfo.ColorPrimaries)
 	}
 
 	video.VideoMetadata = &videoMetadata
@@ -92,3 +122,64 @@ func ScanVideoMetadata(tx *gorm.DB, video *models.Media) error {
 
 	return nil
 }
+
+// parseVideoStreams returns the audio and subtitle streams of the video
+func parseVideoStreams(data *ffprobe.ProbeData) []models.VideoStream {
+	streams := make([]models.VideoStream, 0)
+
+	for _, stream := range data.Streams {
+		var streamType models.VideoStreamType
+		switch ffprobe.StreamType(stream.CodecType) {
+		case ffprobe.StreamAudio:
+			streamType = models.VideoStreamAudio
+		case ffprobe.StreamSubtitle:
+			streamType = models.VideoStreamSubtitle
+		default:
+			continue
+		}
+
+		language, _ := stream.TagList.GetString("language")
+		title, _ := stream.TagList.GetString("title")
+
+		videoStream := models.VideoStream{
+			Index:    stream.Index,
+			Type:     streamType,
+			Codec:    optionalString(stream.CodecName),
+			Language: optionalString(language),
+			Title:    optionalString(title),
+			Default:  stream.Disposition.Default == 1,
+		}
+
+		if streamType == models.VideoStreamAudio {
+			channels := stream.Channels
+			videoStream.Channels = &channels
+		}
+
+		streams = append(streams, videoStream)
+	}
+
+	return streams
+}
+
+func parseVideoChapters(data *ffprobe.ProbeData) []models.VideoChapter {
+	chapters := make([]models.VideoChapter, 0, len(data.Chapters))
+
+	for _, chapter := range data.Chapters {
+		chapters = append(chapters, models.VideoChapter{
+			StartTime: chapter.StartTimeSeconds,
+			EndTime:   chapter.EndTimeSeconds,
+			Title:     optionalString(chapter.Title()),
+		})
+	}
+
+	return chapters
+}
+
+// optionalString returns nil for empty and unknown values reported by ffprobe
+func optionalString(value string) *string {
+	if value == "" || value == "unknown" {
+		return nil
+	}
+
+	return &value
+}
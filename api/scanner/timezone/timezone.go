@@ -0,0 +1,407 @@
+// Package timezone finds the timezone of a location, using the timezone boundaries of timezone-boundary-builder
+// so no online service is needed.
+package timezone
+
+import (
+	"bufio"
+	"encoding/json"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	// The timezone database is embedded, as it is not installed in all containers
+	_ "time/tzdata"
+
+	"github.com/photoview/photoview/api/utils"
+	"github.com/pkg/errors"
+)
+
+// Boundaries holds the areas covered by each timezone
+type Boundaries struct {
+	polygons []polygon
+	// grid holds the indices of the polygons whose bounding box overlaps each cell, in the order of the file
+	grid map[gridCell][]int
+}
+
+// gridCell is a square of one degree of latitude and longitude
+type gridCell struct {
+	latitude, longitude int
+}
+
+func gridCellOf(latitude, longitude float64) gridCell {
+	return gridCell{
+		latitude:  int(math.Floor(latitude)),
+		longitude: int(math.Floor(longitude)),
+	}
+}
+
+// polygon is an outer ring followed by the rings of its holes, with points as longitude and latitude.
+// The points are stored as float32, which is precise to about a metre, to keep the boundaries of all timezones small.
+type polygon struct {
+	zone                             string
+	rings                            [][][2]float32
+	minLong, minLat, maxLong, maxLat float64
+}
+
+// simplifyTolerance is the distance in degrees, about 50 metres, under which consecutive points of a boundary
+// are merged. The boundaries are far more detailed than needed for finding the timezone of photos.
+const simplifyTolerance = 0.0005
+
+// LoadBoundaries reads the timezone boundaries from a GeoJSON file, where the name of the timezone
+// of each feature is stored in the tzid property. The file is decoded as a stream straight into the polygons,
+// as the boundaries of all timezones take hundreds of megabytes as decoded JSON values.
+func LoadBoundaries(path string) (*Boundaries, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open timezone boundaries")
+	}
+	defer file.Close()
+
+	boundaries := Boundaries{
+		polygons: make([]polygon, 0),
+		grid:     make(map[gridCell][]int),
+	}
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	err = decodeObject(decoder, func(key string) error {
+		if key != "features" {
+			return skipValue(decoder)
+		}
+
+		return decodeArray(decoder, func() error {
+			return boundaries.decodeFeature(decoder)
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "decode timezone boundaries (%s)", path)
+	}
+
+	return &boundaries, nil
+}
+
+// decodeFeature reads a feature of the collection, and adds the polygons of its geometry
+func (b *Boundaries) decodeFeature(decoder *json.Decoder) error {
+	var zone string
+	var coordinates [][][][2]float32
+
+	err := decodeObject(decoder, func(key string) error {
+		switch key {
+		case "properties":
+			var properties struct {
+				TZID string `json:"tzid"`
+			}
+			if err := decoder.Decode(&properties); err != nil {
+				return err
+			}
+			zone = properties.TZID
+			return nil
+		case "geometry":
+			return decodeObject(decoder, func(key string) error {
+				if key != "coordinates" {
+					return skipValue(decoder)
+				}
+
+				var err error
+				coordinates, err = decodeCoordinates(decoder)
+				return err
+			})
+		default:
+			return skipValue(decoder)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, rings := range coordinates {
+		if p, ok := newPolygon(zone, rings); ok {
+			b.add(p)
+		}
+	}
+
+	return nil
+}
+
+// decodeCoordinates reads the coordinates of a Polygon or a MultiPolygon geometry, and returns its polygons.
+// The kind of each array is told by its elements, such that the type of the geometry does not need to be read first.
+func decodeCoordinates(decoder *json.Decoder) ([][][][2]float32, error) {
+	// The kinds of elements of an array
+	const (
+		empty = iota
+		numbers
+		positions
+		rings
+		polygons
+	)
+
+	var (
+		result       [][][][2]float32
+		polygonRings [][][2]float32
+		ring         [][2]float32
+		position     []float64
+		// elements holds the kind of the elements of each open array
+		elements []int
+	)
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case json.Delim:
+			if token == '[' {
+				elements = append(elements, empty)
+				continue
+			}
+			if token != ']' {
+				return nil, errors.Errorf("unexpected %s in coordinates", token)
+			}
+
+			closed := elements[len(elements)-1]
+			elements = elements[:len(elements)-1]
+
+			kind := empty
+			switch closed {
+			case numbers:
+				if len(position) >= 2 {
+					ring = appendSimplified(ring, [2]float32{float32(position[0]), float32(position[1])})
+				}
+				position = position[:0]
+				kind = positions
+			case positions:
+				polygonRings = append(polygonRings, ring)
+				ring = nil
+				kind = rings
+			case rings:
+				result = append(result, polygonRings)
+				polygonRings = nil
+				kind = polygons
+			}
+
+			if len(elements) == 0 {
+				return result, nil
+			}
+			if kind != empty {
+				elements[len(elements)-1] = kind
+			}
+		case float64:
+			if len(elements) == 0 {
+				return nil, errors.New("coordinates are not an array")
+			}
+			elements[len(elements)-1] = numbers
+			position = append(position, token)
+		default:
+			return nil, errors.Errorf("unexpected %v in coordinates", token)
+		}
+	}
+}
+
+// appendSimplified adds the point to the ring, unless it is closer than the tolerance to the previous point
+func appendSimplified(ring [][2]float32, point [2]float32) [][2]float32 {
+	if len(ring) > 0 {
+		previous := ring[len(ring)-1]
+		if math.Abs(float64(point[0]-previous[0])) < simplifyTolerance && math.Abs(float64(point[1]-previous[1])) < simplifyTolerance {
+			return ring
+		}
+	}
+
+	return append(ring, point)
+}
+
+// decodeObject reads a JSON object, calling decodeValue to read the value of each key
+func decodeObject(decoder *json.Decoder, decodeValue func(key string) error) error {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := token.(string)
+		if !ok {
+			return errors.Errorf("unexpected %v instead of an object key", token)
+		}
+
+		if err := decodeValue(key); err != nil {
+			return errors.Wrap(err, key)
+		}
+	}
+
+	return expectDelim(decoder, '}')
+}
+
+// decodeArray reads a JSON array, calling decodeElement to read each of its elements
+func decodeArray(decoder *json.Decoder, decodeElement func() error) error {
+	if err := expectDelim(decoder, '['); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		if err := decodeElement(); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(decoder, ']')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return errors.Errorf("unexpected %v instead of %s", token, delim)
+	}
+
+	return nil
+}
+
+// skipValue reads the next JSON value without keeping it
+func skipValue(decoder *json.Decoder) error {
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		if delim, ok := token.(json.Delim); ok {
+			if delim == '[' || delim == '{' {
+				depth++
+			} else {
+				depth--
+			}
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// add stores the polygon in every cell of the grid overlapped by its bounding box
+func (b *Boundaries) add(p polygon) {
+	index := len(b.polygons)
+	b.polygons = append(b.polygons, p)
+
+	minCell := gridCellOf(p.minLat, p.minLong)
+	maxCell := gridCellOf(p.maxLat, p.maxLong)
+	for latitude := minCell.latitude; latitude <= maxCell.latitude; latitude++ {
+		for longitude := minCell.longitude; longitude <= maxCell.longitude; longitude++ {
+			key := gridCell{latitude: latitude, longitude: longitude}
+			b.grid[key] = append(b.grid[key], index)
+		}
+	}
+}
+
+func newPolygon(zone string, rings [][][2]float32) (polygon, bool) {
+	p := polygon{
+		zone:    zone,
+		minLong: 180, minLat: 90,
+		maxLong: -180, maxLat: -90,
+	}
+
+	// Polygons smaller than the tolerance of the simplification have no outer ring left
+	if len(rings) == 0 || len(rings[0]) < 3 {
+		return p, false
+	}
+
+	for _, ring := range rings {
+		if len(ring) >= 3 {
+			p.rings = append(p.rings, ring)
+		}
+	}
+
+	// The outer ring contains the holes, so it alone gives the bounding box
+	for _, point := range p.rings[0] {
+		p.minLong = min(p.minLong, float64(point[0]))
+		p.maxLong = max(p.maxLong, float64(point[0]))
+		p.minLat = min(p.minLat, float64(point[1]))
+		p.maxLat = max(p.maxLat, float64(point[1]))
+	}
+
+	return p, true
+}
+
+// contains tells if the point is inside the outer ring of the polygon and outside its holes.
+// Counting the crossings with all rings together gives this result, as the holes are inside the outer ring.
+func (p *polygon) contains(longitude, latitude float64) bool {
+	if longitude < p.minLong || longitude > p.maxLong || latitude < p.minLat || latitude > p.maxLat {
+		return false
+	}
+
+	inside := false
+	for _, ring := range p.rings {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			aLong, aLat := float64(ring[i][0]), float64(ring[i][1])
+			bLong, bLat := float64(ring[j][0]), float64(ring[j][1])
+			if (aLat > latitude) != (bLat > latitude) &&
+				longitude < (bLong-aLong)*(latitude-aLat)/(bLat-aLat)+aLong {
+				inside = !inside
+			}
+		}
+	}
+
+	return inside
+}
+
+// Lookup returns the name of the timezone at the coordinates, or an empty string if no timezone covers them
+func (b *Boundaries) Lookup(latitude, longitude float64) string {
+	for _, index := range b.grid[gridCellOf(latitude, longitude)] {
+		if b.polygons[index].contains(longitude, latitude) {
+			return b.polygons[index].zone
+		}
+	}
+
+	return ""
+}
+
+var (
+	globalBoundaries     *Boundaries
+	loadGlobalBoundaries sync.Once
+)
+
+// Location returns the timezone at the coordinates, using the boundaries from the file set by the
+// PHOTOVIEW_TIMEZONE_BOUNDARIES environment variable. It returns nil if the timezone is unknown.
+func Location(latitude, longitude float64) *time.Location {
+	loadGlobalBoundaries.Do(func() {
+		path := utils.EnvTimezoneBoundaries.GetValue()
+		if path == "" {
+			return
+		}
+
+		boundaries, err := LoadBoundaries(path)
+		if err != nil {
+			log.Printf("WARN: could not load timezone boundaries, timezones will not be derived from locations: %s\n", err)
+			return
+		}
+
+		globalBoundaries = boundaries
+	})
+
+	if globalBoundaries == nil {
+		return nil
+	}
+
+	name := globalBoundaries.Lookup(latitude, longitude)
+	if name == "" {
+		return nil
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		log.Printf("WARN: unknown timezone %s in timezone boundaries: %s\n", name, err)
+		return nil
+	}
+
+	return location
+}
@@ -0,0 +1,91 @@
+package timezone_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/photoview/photoview/api/scanner/timezone"
+	"github.com/photoview/photoview/api/test_utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(test_utils.UnitTestRun(m))
+}
+
+func TestLookup(t *testing.T) {
+	// Square zones, where the first one has a hole covered by the last zone.
+	// The keys of the Sao Paulo feature are in another order and it has a point closer than the simplification tolerance.
+	boundariesJSON := `{
+  "type": "FeatureCollection",
+  "features": [
+    {
+      "type": "Feature",
+      "properties": {"tzid": "Europe/Paris"},
+      "geometry": {
+        "type": "Polygon",
+        "coordinates": [
+          [[0, 40], [10, 40], [10, 50], [0, 50], [0, 40]],
+          [[4, 44], [6, 44], [6, 46], [4, 46], [4, 44]]
+        ]
+      }
+    },
+    {
+      "type": "Feature",
+      "properties": {"tzid": "Asia/Tokyo"},
+      "geometry": {
+        "type": "MultiPolygon",
+        "coordinates": [
+          [[[130, 30], [140, 30], [140, 40], [130, 40], [130, 30]]],
+          [[[141, 41], [142, 41], [142, 42], [141, 42], [141, 41]]]
+        ]
+      }
+    },
+    {
+      "type": "Feature",
+      "bbox": [-50.5, -25.5, -40.5, -20.5],
+      "geometry": {
+        "coordinates": [[[-50.5, -25.5], [-40.5, -25.5], [-40.5, -25.49999], [-40.5, -20.5], [-50.5, -20.5], [-50.5, -25.5]]],
+        "type": "Polygon"
+      },
+      "properties": {"tzid": "America/Sao_Paulo", "source": {"names": ["a", "b"]}}
+    },
+    {
+      "type": "Feature",
+      "properties": {"tzid": "Pacific/Tiny"},
+      "geometry": {
+        "type": "Polygon",
+        "coordinates": [[[170, 10], [170.0001, 10], [170.0001, 10.0001], [170, 10]]]
+      }
+    },
+    {
+      "type": "Feature",
+      "properties": {"tzid": "Europe/Zurich"},
+      "geometry": {
+        "type": "Polygon",
+        "coordinates": [[[4, 44], [6, 44], [6, 46], [4, 46], [4, 44]]]
+      }
+    }
+  ]
+}`
+
+	boundariesPath := path.Join(t.TempDir(), "timezones.json")
+	assert.NoError(t, os.WriteFile(boundariesPath, []byte(boundariesJSON), 0644))
+
+	boundaries, err := timezone.LoadBoundaries(boundariesPath)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Europe/Paris", boundaries.Lookup(48.85, 2.35))
+	assert.Equal(t, "Europe/Zurich", boundaries.Lookup(45, 5))
+	assert.Equal(t, "Asia/Tokyo", boundaries.Lookup(35.68, 139.69))
+	assert.Equal(t, "Asia/Tokyo", boundaries.Lookup(41.5, 141.5))
+	assert.Equal(t, "America/Sao_Paulo", boundaries.Lookup(-23.55, -46.63))
+	assert.Equal(t, "America/Sao_Paulo", boundaries.Lookup(-25.2, -50.2), "cells are found for negative coordinates")
+	assert.Equal(t, "", boundaries.Lookup(-25.7, -50.2))
+	assert.Equal(t, "", boundaries.Lookup(-33.87, 151.21))
+	assert.Equal(t, "", boundaries.Lookup(10.00005, 170.00008), "polygons smaller than the simplification tolerance are left out")
+
+	_, err = timezone.LoadBoundaries(path.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 	"net/http"
+	"os"
 	"path"
 
 	"github.com/gorilla/handlers"
@@ -17,6 +18,7 @@ import (
 	"github.com/photoview/photoview/api/routes"
 	"github.com/photoview/photoview/api/scanner/exif"
 	"github.com/photoview/photoview/api/scanner/face_detection"
+	"github.com/photoview/photoview/api/scanner/geocode"
 	"github.com/photoview/photoview/api/scanner/media_encoding/executable_worker"
 	"github.com/photoview/photoview/api/scanner/periodic_scanner"
 	"github.com/photoview/photoview/api/scanner/scanner_queue"
@@ -46,6 +48,13 @@ func main() {
 		log.Panicf("Could not migrate database: %s\n", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == refreshMetadataCommand {
+		if err := runRefreshMetadataCommand(db, os.Args[2:]); err != nil {
+			log.Fatalf("Could not refresh metadata: %s\n", err)
+		}
+		return
+	}
+
 	if err := scanner_queue.InitializeScannerQueue(db); err != nil {
 		log.Panicf("Could not initialize scanner queue: %s\n", err)
 	}
@@ -58,6 +67,19 @@ func main() {
 
 	exif.InitializeEXIFParser()
 
+	if cities := geocode.InitializeCities(); cities != nil {
+		// Give a place to the media scanned before the cities were available
+		go func() {
+			added, err := exif.AddMissingPlaces(db, cities)
+			if err != nil {
+				log.Printf("WARN: could not add places to existing media: %s\n", err)
+			}
+			if added > 0 {
+				log.Printf("Added places to %d existing media\n", added)
+			}
+		}()
+	}
+
 	if err := face_detection.InitializeFaceDetector(db); err != nil {
 		log.Panicf("Could not initialize face detector: %s\n", err)
 	}
@@ -89,6 +111,9 @@ func main() {
 	videoRouter := endpointRouter.PathPrefix("/video").Subrouter()
 	routes.RegisterVideoRoutes(db, videoRouter)
 
+	hlsRouter := endpointRouter.PathPrefix("/hls").Subrouter()
+	routes.RegisterHLSRoutes(db, hlsRouter)
+
 	downloadsRouter := endpointRouter.PathPrefix("/download").Subrouter()
 	routes.RegisterDownloadRoutes(db, downloadsRouter)
 
@@ -38,6 +38,55 @@ const (
 	EnvDisableFaceRecognition EnvironmentVariable = "PHOTOVIEW_DISABLE_FACE_RECOGNITION"
 	EnvDisableVideoEncoding   EnvironmentVariable = "PHOTOVIEW_DISABLE_VIDEO_ENCODING"
 	EnvDisableRawProcessing   EnvironmentVariable = "PHOTOVIEW_DISABLE_RAW_PROCESSING"
+	EnvEnableHLS              EnvironmentVariable = "PHOTOVIEW_ENABLE_HLS"
+	EnvEmbedSRGBProfile       EnvironmentVariable = "PHOTOVIEW_EMBED_SRGB_PROFILE"
+	// EnvXMPWriteBack enables writing metadata edited in Photoview to the XMP sidecars of the media.
+	// Ratings, flags and labels are only written by admins and by the only user of an album, as they are given per user.
+	EnvXMPWriteBack EnvironmentVariable = "PHOTOVIEW_XMP_WRITEBACK"
+)
+
+// RAW converter related
+const (
+	EnvRawConverters      EnvironmentVariable = "PHOTOVIEW_RAW_CONVERTERS"
+	EnvDarktableStyle     EnvironmentVariable = "PHOTOVIEW_DARKTABLE_STYLE"
+	EnvDarktableConfigDir EnvironmentVariable = "PHOTOVIEW_DARKTABLE_CONFIG_DIR"
+	EnvRawTherapeeProfile EnvironmentVariable = "PHOTOVIEW_RAWTHERAPEE_PROFILE"
+	EnvDcrawArguments     EnvironmentVariable = "PHOTOVIEW_DCRAW_ARGUMENTS"
+	// EnvRawPrimaryVersion is set to raw to generate the previews of RAW+JPEG pairs from the RAW file instead of the JPEG
+	EnvRawPrimaryVersion EnvironmentVariable = "PHOTOVIEW_RAW_PRIMARY_VERSION"
+)
+
+// Metadata of generated images, set to either all, basic or none
+const (
+	EnvHighResMetadata   EnvironmentVariable = "PHOTOVIEW_HIGHRES_METADATA"
+	EnvThumbnailMetadata EnvironmentVariable = "PHOTOVIEW_THUMBNAIL_METADATA"
+)
+
+// EnvEditedFilenamePattern is a regular expression matching the end of the filenames of edited copies,
+// the original has the same filename with the match removed
+const EnvEditedFilenamePattern EnvironmentVariable = "PHOTOVIEW_EDITED_FILENAME_PATTERN"
+
+// EnvTimezoneBoundaries is the path to a GeoJSON file with the boundaries of the timezones, as released by
+// timezone-boundary-builder. It is used to find the timezone of photos from their location, when their metadata has none.
+const EnvTimezoneBoundaries EnvironmentVariable = "PHOTOVIEW_TIMEZONE_BOUNDARIES"
+
+// EnvGeonamesCities is the path to a GeoNames extract of cities, such as `cities15000.txt`. It is used to find the country,
+// region and city of media from their location. The names of regions and countries are read from the same directory.
+// When it is first set, the media already scanned are given a place in the background at startup.
+const EnvGeonamesCities EnvironmentVariable = "PHOTOVIEW_GEONAMES_CITIES"
+
+// Geotagging from GPX tracks, the values are durations such as `10m` or `-1h30m`
+const (
+	// EnvGPXMaxGap is the longest time between a photo and the track points around it for the photo to be geotagged
+	EnvGPXMaxGap EnvironmentVariable = "PHOTOVIEW_GPX_MAX_GAP"
+	// EnvGPXClockOffset is added to the capture time of the photos, to correct a camera clock that was wrong
+	EnvGPXClockOffset EnvironmentVariable = "PHOTOVIEW_GPX_CLOCK_OFFSET"
+)
+
+// Video transcoding related
+const (
+	EnvVideoProfile      EnvironmentVariable = "PHOTOVIEW_VIDEO_PROFILE"
+	EnvVideoProfilesPath EnvironmentVariable = "PHOTOVIEW_VIDEO_PROFILES_PATH"
 )
 
 // GetName returns the name of the environment variable itself
@@ -83,3 +132,9 @@ func UIPath() string {
 
 	return "./ui"
 }
+
+// RawIsPrimaryVersion returns true when the previews of a RAW file should be generated from the RAW file itself,
+// instead of from the JPEG file written alongside it by the camera
+func RawIsPrimaryVersion() bool {
+	return strings.ToLower(EnvRawPrimaryVersion.GetValue()) == "raw"
+}
@@ -39,12 +39,12 @@ const DateSelector = ({ filterDate, setFilterDate }: DateSelectorProps) => {
 
   if (data) {
     if (data.myMedia.length != 0) {
+      // The date of media starts with the local date at the place of capture
       const dateStr = data.myMedia[0].date
-      const date = new Date(dateStr)
       const now = new Date()
 
       const currentYear = now.getFullYear()
-      const earliestYear = date.getFullYear()
+      const earliestYear = Number(dateStr.substring(0, 4))
 
       const years: number[] = []
       for (let i = currentYear - 1; i >= earliestYear; i--) {
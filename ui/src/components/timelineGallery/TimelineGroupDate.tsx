@@ -6,10 +6,13 @@ import {
   TimelineGalleryState,
 } from './timelineGalleryReducer'
 
+// The date of a group is the day of capture at midnight UTC,
+// so it is formatted in UTC to show the same day in every timezone
 const dateFormatterOptions: Intl.DateTimeFormatOptions = {
   year: 'numeric',
   month: 'long',
   day: 'numeric',
+  timeZone: 'UTC',
 }
 
 type TimelineGroupDateProps = {
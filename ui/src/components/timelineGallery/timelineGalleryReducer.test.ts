@@ -431,4 +431,21 @@ describe('timeline gallery reducer', () => {
       presenting: false,
     })
   })
+
+  test('group by the local day of capture', () => {
+    const state = timelineGalleryReducer(defaultEmptyState, {
+      type: 'replaceTimelineGroups',
+      timeline: [
+        { ...timelineData[0], date: '2020-12-13T23:30:00+09:00' },
+        { ...timelineData[0], id: '2000', date: '2020-12-13T08:00:00Z' },
+        { ...timelineData[0], id: '2001', date: '2020-12-12T22:00:00-05:00' },
+      ],
+    })
+
+    expect(state.timelineGroups.map(group => group.date)).toEqual([
+      '2020-12-13T00:00:00Z',
+      '2020-12-12T00:00:00Z',
+    ])
+    expect(state.timelineGroups[0].albums[0].media).toHaveLength(2)
+  })
 })
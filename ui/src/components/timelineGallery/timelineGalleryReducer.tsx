@@ -190,6 +190,11 @@ export const getActiveTimelineImage = ({
   return getTimelineImage({ mediaState, index: mediaState.activeIndex })
 }
 
+// The date of a media is the local time at the place of capture, followed by the offset of its timezone.
+// Media are grouped by the day at the place of capture, which is the date part of the date,
+// and the date of a group is that day at midnight UTC.
+const localDay = (date: string) => date.substring(0, 10)
+
 function convertMediaToTimelineGroups(
   timelineMedia: myTimeline_myTimeline[]
 ): TimelineGroup[] {
@@ -197,12 +202,7 @@ function convertMediaToTimelineGroups(
   let albums: TimelineGroupAlbum[] = []
   let nextAlbum: TimelineGroupAlbum | null = null
 
-  const sameDay = (a: string, b: string) => {
-    return (
-      a.replace(/\d{2}:\d{2}:\d{2}/, '00:00:00') ==
-      b.replace(/\d{2}:\d{2}:\d{2}/, '00:00:00')
-    )
-  }
+  const sameDay = (a: string, b: string) => localDay(a) == localDay(b)
 
   for (const media of timelineMedia) {
     if (nextAlbum == null) {
@@ -219,7 +219,7 @@ function convertMediaToTimelineGroups(
       albums.push(nextAlbum)
 
       timelineGroups.push({
-        date: albums[0].media[0].date.replace(/\d{2}:\d{2}:\d{2}/, '00:00:00'),
+        date: `${localDay(albums[0].media[0].date)}T00:00:00Z`,
         albums: albums,
       })
       albums = []
@@ -250,7 +250,7 @@ function convertMediaToTimelineGroups(
     albums.push(nextAlbum)
 
     timelineGroups.push({
-      date: albums[0].media[0].date.replace(/\d{2}:\d{2}:\d{2}/, '00:00:00'),
+      date: `${localDay(albums[0].media[0].date)}T00:00:00Z`,
       albums: albums,
     })
   }